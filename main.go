@@ -6,8 +6,10 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"log"
+	"os"
 	"terraform-provider-pf/provider"
 )
 
@@ -24,13 +26,25 @@ func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-debug]\n       %s fn <function> [arguments...]\n\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
+	// Evaluate a provider function outside of Terraform (e.g., fn cidrs_overlap '["10.0.0.0/16", "10.0.0.0/8"]')
+	if flag.Arg(0) == "fn" {
+		os.Exit(provider.RunFunctionCLI(context.Background(), flag.Args()[1:], os.Stdout, os.Stderr))
+	}
+
+	// When debug is true, the provider is not started by Terraform. Instead, it prints the
+	// TF_REATTACH_PROVIDERS configuration to stdout and serves until it is interrupted (Ctrl-C).
 	err := providerserver.Serve(
 		context.Background(),
 		provider.New,
 		providerserver.ServeOpts{
 			Address: "registry.terraform.io/panfactum/pf",
+			Debug:   debug,
 		},
 	)
 
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+)
+
+/**************************************************************
+  ARN Components
+ **************************************************************/
+
+type awsARN struct {
+	Partition    types.String `tfsdk:"partition"`
+	Service      types.String `tfsdk:"service"`
+	Region       types.String `tfsdk:"region"`
+	AccountID    types.String `tfsdk:"account_id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+}
+
+var awsARNAttrTypes = map[string]attr.Type{
+	"partition":     types.StringType,
+	"service":       types.StringType,
+	"region":        types.StringType,
+	"account_id":    types.StringType,
+	"resource_type": types.StringType,
+	"resource_id":   types.StringType,
+}
+
+var (
+	awsPartitions = []string{"aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b", "aws-iso-e", "aws-iso-f", "aws-eusc"}
+
+	// Region name prefixes and the partition that contains them. More specific prefixes come
+	// first so that, for example, us-gov- is matched before us-.
+	awsPartitionRegionPrefixes = []struct {
+		prefix    string
+		partition string
+	}{
+		{"cn-", "aws-cn"},
+		{"us-gov-", "aws-us-gov"},
+		{"us-isob-", "aws-iso-b"},
+		{"us-iso-", "aws-iso"},
+		{"eu-isoe-", "aws-iso-e"},
+		{"us-isof-", "aws-iso-f"},
+		{"eusc-de-", "aws-eusc"},
+		{"af-", "aws"},
+		{"ap-", "aws"},
+		{"ca-", "aws"},
+		{"eu-", "aws"},
+		{"il-", "aws"},
+		{"me-", "aws"},
+		{"mx-", "aws"},
+		{"sa-", "aws"},
+		{"us-", "aws"},
+	}
+
+	// The part of a region name that follows its prefix (e.g., east-2 in us-east-2)
+	awsRegionLocationRegex = regexp.MustCompile(`^(central|north|south|east|west|northeast|northwest|southeast|southwest)-[0-9]+$`)
+
+	awsServiceRegex   = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	awsAccountIDRegex = regexp.MustCompile(`^[0-9]{12}$`)
+	awsARNRegionRegex = regexp.MustCompile(`^[a-z]{2,4}(-[a-z]+)+-[0-9]+$`)
+)
+
+// awsPartitionForRegion returns the partition that contains the given region. It is also
+// used to validate the region of the provider configuration.
+func awsPartitionForRegion(region string) (string, error) {
+	for _, entry := range awsPartitionRegionPrefixes {
+		if strings.HasPrefix(region, entry.prefix) && awsRegionLocationRegex.MatchString(region[len(entry.prefix):]) {
+			return entry.partition, nil
+		}
+	}
+	return "", fmt.Errorf("'%s' is not a valid AWS region name (e.g., us-east-2)", region)
+}
+
+/**************************************************************
+  Service Rules
+ **************************************************************/
+
+type arnRequirement int
+
+const (
+	arnOptional arnRequirement = iota
+	arnRequired
+	arnForbidden
+)
+
+type arnServiceRule struct {
+	region  arnRequirement
+	account arnRequirement
+
+	// separator is placed between the resource type and resource id
+	separator string
+
+	// untyped services identify resources by id alone (e.g., S3 buckets and SQS queues) unless the
+	// resource begins with one of the given types
+	untyped bool
+	types   []string
+}
+
+var arnServiceRules = map[string]arnServiceRule{
+	"acm":                  {region: arnRequired, account: arnRequired, separator: "/"},
+	"cloudfront":           {region: arnForbidden, account: arnRequired, separator: "/"},
+	"dynamodb":             {region: arnRequired, account: arnRequired, separator: "/"},
+	"ec2":                  {region: arnRequired, account: arnRequired, separator: "/"},
+	"ecr":                  {region: arnRequired, account: arnRequired, separator: "/"},
+	"eks":                  {region: arnRequired, account: arnRequired, separator: "/"},
+	"elasticache":          {region: arnRequired, account: arnRequired, separator: ":"},
+	"elasticloadbalancing": {region: arnRequired, account: arnRequired, separator: "/"},
+	"events":               {region: arnRequired, account: arnRequired, separator: "/"},
+	"iam":                  {region: arnForbidden, account: arnRequired, separator: "/"},
+	"kms":                  {region: arnRequired, account: arnRequired, separator: "/"},
+	"lambda":               {region: arnRequired, account: arnRequired, separator: ":"},
+	"logs":                 {region: arnRequired, account: arnRequired, separator: ":"},
+	"rds":                  {region: arnRequired, account: arnRequired, separator: ":"},
+	"route53":              {region: arnForbidden, account: arnForbidden, separator: "/"},
+	"s3":                   {region: arnOptional, account: arnOptional, separator: "/", untyped: true, types: []string{"accesspoint", "job", "storage-lens"}},
+	"secretsmanager":       {region: arnRequired, account: arnRequired, separator: ":"},
+	"sns":                  {region: arnRequired, account: arnRequired, untyped: true},
+	"sqs":                  {region: arnRequired, account: arnRequired, untyped: true},
+	"ssm":                  {region: arnRequired, account: arnRequired, separator: "/"},
+	"states":               {region: arnRequired, account: arnRequired, separator: ":"},
+	"sts":                  {region: arnForbidden, account: arnRequired, separator: "/"},
+}
+
+/**************************************************************
+  Parsing and Building
+ **************************************************************/
+
+// parseARN splits an ARN into its components. Only the first five colons delimit components,
+// so resources that contain colons (e.g., CloudWatch log groups) are preserved.
+func parseARN(input string) (*awsARN, error) {
+	sections := strings.SplitN(input, ":", 6)
+	if len(sections) != 6 || sections[0] != "arn" {
+		return nil, fmt.Errorf("'%s' is not a valid ARN: expected the format arn:partition:service:region:account-id:resource", input)
+	}
+	partition, service, region, accountID, resource := sections[1], sections[2], sections[3], sections[4], sections[5]
+
+	if !containsString(awsPartitions, partition) {
+		return nil, fmt.Errorf("'%s' is not a valid ARN: '%s' is not a known partition (%s)", input, partition, strings.Join(awsPartitions, ", "))
+	}
+	if !awsServiceRegex.MatchString(service) {
+		return nil, fmt.Errorf("'%s' is not a valid ARN: '%s' is not a valid service namespace", input, service)
+	}
+	if region != "" && !awsARNRegionRegex.MatchString(region) {
+		return nil, fmt.Errorf("'%s' is not a valid ARN: '%s' is not a valid region", input, region)
+	}
+	if accountID != "" && accountID != "aws" && !awsAccountIDRegex.MatchString(accountID) {
+		return nil, fmt.Errorf("'%s' is not a valid ARN: '%s' is not a valid 12-digit account id", input, accountID)
+	}
+	if resource == "" {
+		return nil, fmt.Errorf("'%s' is not a valid ARN: the resource is empty", input)
+	}
+
+	resourceType, resourceID := splitARNResource(service, resource)
+
+	return &awsARN{
+		Partition:    types.StringValue(partition),
+		Service:      types.StringValue(service),
+		Region:       optionalStringValue(region),
+		AccountID:    optionalStringValue(accountID),
+		ResourceType: optionalStringValue(resourceType),
+		ResourceID:   types.StringValue(resourceID),
+	}, nil
+}
+
+// splitARNResource splits a resource into its type and id. Resources that do not have a
+// type (e.g., S3 buckets or API Gateway paths) return an empty type.
+func splitARNResource(service string, resource string) (string, string) {
+	rule, known := arnServiceRules[service]
+	if known && rule.untyped {
+		for _, resourceType := range rule.types {
+			if strings.HasPrefix(resource, resourceType+rule.separator) {
+				return resourceType, strings.TrimPrefix(resource, resourceType+rule.separator)
+			}
+		}
+		return "", resource
+	}
+
+	if known {
+		if index := strings.Index(resource, rule.separator); index > 0 {
+			return resource[:index], resource[index+1:]
+		}
+		return "", resource
+	}
+
+	// Unknown services are only split on '/' so that buildARN can reassemble the same ARN
+	if index := strings.Index(resource, "/"); index > 0 {
+		return resource[:index], resource[index+1:]
+	}
+	return "", resource
+}
+
+// buildARN assembles an ARN, validating the components against the rules for the service.
+// If the partition is null, it is derived from the region (or 'aws' if the region is also null).
+func buildARN(components awsARN) (string, error) {
+	service := components.Service.ValueString()
+	region := components.Region.ValueString()
+	accountID := components.AccountID.ValueString()
+	resourceType := components.ResourceType.ValueString()
+	resourceID := components.ResourceID.ValueString()
+
+	if !awsServiceRegex.MatchString(service) {
+		return "", newArgumentError(arnBuildArgumentService, "'%s' is not a valid service namespace", service)
+	}
+	if resourceID == "" {
+		return "", newArgumentError(arnBuildArgumentResourceID, "resource_id must not be empty")
+	}
+
+	partition := components.Partition.ValueString()
+	if region != "" {
+		regionPartition, err := awsPartitionForRegion(region)
+		if err != nil {
+			return "", &argumentError{argument: arnBuildArgumentRegion, err: err}
+		}
+		if partition == "" {
+			partition = regionPartition
+		} else if partition != regionPartition {
+			return "", newArgumentError(arnBuildArgumentPartition, "region '%s' is in the '%s' partition, not '%s'", region, regionPartition, partition)
+		}
+	} else if partition == "" {
+		partition = "aws"
+	}
+	if !containsString(awsPartitions, partition) {
+		return "", newArgumentError(arnBuildArgumentPartition, "'%s' is not a known partition (%s)", partition, strings.Join(awsPartitions, ", "))
+	}
+
+	if accountID != "" && accountID != "aws" && !awsAccountIDRegex.MatchString(accountID) {
+		return "", newArgumentError(arnBuildArgumentAccountID, "'%s' is not a valid 12-digit account id", accountID)
+	}
+
+	separator := "/"
+	if rule, known := arnServiceRules[service]; known {
+		switch {
+		case rule.region == arnRequired && region == "":
+			return "", newArgumentError(arnBuildArgumentRegion, "%s ARNs require a region (e.g., data.pf_metadata.region)", service)
+		case rule.region == arnForbidden && region != "":
+			return "", newArgumentError(arnBuildArgumentRegion, "%s ARNs must not have a region as %s is a global service", service, service)
+		case rule.account == arnRequired && accountID == "":
+			return "", newArgumentError(arnBuildArgumentAccountID, "%s ARNs require an account id", service)
+		case rule.account == arnForbidden && accountID != "":
+			return "", newArgumentError(arnBuildArgumentAccountID, "%s ARNs must not have an account id", service)
+		}
+
+		if rule.untyped && resourceType != "" && !containsString(rule.types, resourceType) {
+			if len(rule.types) == 0 {
+				return "", newArgumentError(arnBuildArgumentResourceType, "%s ARNs do not have a resource type", service)
+			}
+			return "", newArgumentError(arnBuildArgumentResourceType, "'%s' is not a valid %s resource type (%s)", resourceType, service, strings.Join(rule.types, ", "))
+		}
+		if rule.separator != "" {
+			separator = rule.separator
+		}
+	}
+
+	resource := resourceID
+	if resourceType != "" {
+		resource = resourceType + separator + resourceID
+	}
+
+	return strings.Join([]string{"arn", partition, service, region, accountID, resource}, ":"), nil
+}
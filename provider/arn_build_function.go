@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = ARNBuildFunction{}
+)
+
+func NewARNBuildFunction() function.Function {
+	return ARNBuildFunction{}
+}
+
+type ARNBuildFunction struct{}
+
+// Positions of the arguments of arn_build so that buildARN can point at the invalid component
+const (
+	arnBuildArgumentPartition int64 = iota
+	arnBuildArgumentService
+	arnBuildArgumentRegion
+	arnBuildArgumentAccountID
+	arnBuildArgumentResourceType
+	arnBuildArgumentResourceID
+)
+
+func (f ARNBuildFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_build"
+}
+
+func (f ARNBuildFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns an AWS ARN built from its components",
+		Description: "Takes the same components returned by arn_parse. Known services are validated (e.g., iam ARNs must not have a region, and lambda ARNs require both a region and an account id) and use the correct separator between the resource type and resource id. If the partition is null, it is derived from the region, or 'aws' if the region is also null. Provider functions cannot read the provider configuration, so pass data.pf_metadata.region and data.pf_metadata.aws_partition to use the provider's region.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     true,
+				AllowUnknownValues: false,
+				Description:        "The partition (e.g., aws or aws-cn). If null, derived from the region.",
+				Name:               "partition",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The service namespace (e.g., iam or s3)",
+				Name:               "service",
+			},
+			function.StringParameter{
+				AllowNullValue:     true,
+				AllowUnknownValues: false,
+				Description:        "The region (e.g., us-east-2). Null for global services.",
+				Name:               "region",
+			},
+			function.StringParameter{
+				AllowNullValue:     true,
+				AllowUnknownValues: false,
+				Description:        "The 12-digit account id. Null for resources without an account (e.g., S3 buckets).",
+				Name:               "account_id",
+			},
+			function.StringParameter{
+				AllowNullValue:     true,
+				AllowUnknownValues: false,
+				Description:        "The resource type (e.g., role). Null for resources without a type (e.g., S3 buckets).",
+				Name:               "resource_type",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The resource id (e.g., my-role)",
+				Name:               "resource_id",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ARNBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var components awsARN
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(
+		ctx,
+		&components.Partition,
+		&components.Service,
+		&components.Region,
+		&components.AccountID,
+		&components.ResourceType,
+		&components.ResourceID,
+	))
+	if resp.Error != nil {
+		return
+
+	}
+
+	arn, err := buildARN(components)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newFuncError(err, -1))
+		return
+	}
+
+	tflog.Debug(ctx, "Built ARN", map[string]any{"service": components.Service.ValueString(), "arn": arn})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, arn))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = ARNParseFunction{}
+)
+
+func NewARNParseFunction() function.Function {
+	return ARNParseFunction{}
+}
+
+type ARNParseFunction struct{}
+
+func (f ARNParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_parse"
+}
+
+func (f ARNParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the components of an AWS ARN",
+		Description: "Returns an object with partition, service, region, account_id, resource_type, and resource_id. Resources that contain colons or slashes (e.g., log groups or S3 object keys) are preserved in resource_id. Components that are empty in the ARN (e.g., the region and account id of S3 buckets) are null, as is resource_type for resources that do not have one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The ARN to parse (e.g., arn:aws:iam::123456789012:role/example)",
+				Name:               "arn",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: awsARNAttrTypes,
+		},
+	}
+}
+
+func (f ARNParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+
+	}
+
+	arn, err := parseARN(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	tflog.Debug(ctx, "Parsed ARN", map[string]any{
+		"arn":           input,
+		"service":       arn.Service.ValueString(),
+		"resource_type": arn.ResourceType.ValueString(),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, arn))
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = ARNPartitionForRegionFunction{}
+)
+
+func NewARNPartitionForRegionFunction() function.Function {
+	return ARNPartitionForRegionFunction{}
+}
+
+type ARNPartitionForRegionFunction struct{}
+
+func (f ARNPartitionForRegionFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_partition_for_region"
+}
+
+func (f ARNPartitionForRegionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the AWS partition that contains a region",
+		Description: "Returns aws-cn for China regions, aws-us-gov for GovCloud regions, the matching aws-iso* partition for isolated regions, aws-eusc for European Sovereign Cloud regions, and aws for the other regions. Region names that do not belong to a known partition are rejected. The partition for the provider's region is also available as data.pf_metadata.aws_partition.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The region (e.g., cn-north-1)",
+				Name:               "region",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ARNPartitionForRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+
+	}
+
+	partition, err := awsPartitionForRegion(region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	tflog.Debug(ctx, "Resolved AWS partition", map[string]any{"region": region, "partition": partition})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, partition))
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestParseARN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected [6]string
+	}{
+		{"arn:aws:iam::123456789012:role/example", [6]string{"aws", "iam", "", "123456789012", "role", "example"}},
+		{"arn:aws:iam::123456789012:role/path/to/example", [6]string{"aws", "iam", "", "123456789012", "role", "path/to/example"}},
+		{"arn:aws:iam::aws:policy/AdministratorAccess", [6]string{"aws", "iam", "", "aws", "policy", "AdministratorAccess"}},
+		{"arn:aws:s3:::my-bucket", [6]string{"aws", "s3", "", "", "", "my-bucket"}},
+		{"arn:aws:s3:::my-bucket/path/to:key", [6]string{"aws", "s3", "", "", "", "my-bucket/path/to:key"}},
+		{"arn:aws:s3:us-west-2:123456789012:accesspoint/example", [6]string{"aws", "s3", "us-west-2", "123456789012", "accesspoint", "example"}},
+		{"arn:aws:logs:us-east-2:123456789012:log-group:/aws/lambda/example:*", [6]string{"aws", "logs", "us-east-2", "123456789012", "log-group", "/aws/lambda/example:*"}},
+		{"arn:aws:lambda:us-east-2:123456789012:function:example:live", [6]string{"aws", "lambda", "us-east-2", "123456789012", "function", "example:live"}},
+		{"arn:aws:sqs:us-east-2:123456789012:queue", [6]string{"aws", "sqs", "us-east-2", "123456789012", "", "queue"}},
+		{"arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-0123456789abcdef0", [6]string{"aws-cn", "ec2", "cn-north-1", "123456789012", "instance", "i-0123456789abcdef0"}},
+		{"arn:aws-us-gov:eks:us-gov-west-1:123456789012:cluster/production", [6]string{"aws-us-gov", "eks", "us-gov-west-1", "123456789012", "cluster", "production"}},
+		{"arn:aws:apigateway:us-east-2::/restapis/abc123", [6]string{"aws", "apigateway", "us-east-2", "", "", "/restapis/abc123"}},
+		{"arn:aws:newservice:us-east-2:123456789012:widget/abc", [6]string{"aws", "newservice", "us-east-2", "123456789012", "widget", "abc"}},
+		{"arn:aws:newservice:us-east-2:123456789012:widget:abc", [6]string{"aws", "newservice", "us-east-2", "123456789012", "", "widget:abc"}},
+	}
+
+	for _, test := range tests {
+		arn, err := parseARN(test.input)
+		if err != nil {
+			t.Fatalf("parseARN(%q) returned error: %v", test.input, err)
+		}
+		result := [6]string{
+			arn.Partition.ValueString(),
+			arn.Service.ValueString(),
+			arn.Region.ValueString(),
+			arn.AccountID.ValueString(),
+			arn.ResourceType.ValueString(),
+			arn.ResourceID.ValueString(),
+		}
+		if result != test.expected {
+			t.Errorf("parseARN(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+
+		// Every parsed ARN must round-trip through buildARN
+		built, err := buildARN(*arn)
+		if err != nil {
+			t.Errorf("buildARN(parseARN(%q)) returned error: %v", test.input, err)
+		} else if built != test.input {
+			t.Errorf("buildARN(parseARN(%q)) = %q", test.input, built)
+		}
+	}
+}
+
+func TestParseARNInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"",
+		"arn:aws:iam::123456789012",
+		"urn:aws:iam::123456789012:role/example",
+		"arn:gcp:iam::123456789012:role/example",
+		"arn:aws:IAM::123456789012:role/example",
+		"arn:aws:ec2:not_a_region:123456789012:instance/i-1",
+		"arn:aws:ec2:us-east-2:1234:instance/i-1",
+		"arn:aws:ec2:us-east-2:123456789012:",
+	} {
+		if _, err := parseARN(input); err == nil {
+			t.Errorf("parseARN(%q) expected an error", input)
+		}
+	}
+}
+
+func TestBuildARN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		components [6]string
+		expected   string
+	}{
+		{[6]string{"", "iam", "", "123456789012", "role", "example"}, "arn:aws:iam::123456789012:role/example"},
+		{[6]string{"", "lambda", "us-east-2", "123456789012", "function", "example"}, "arn:aws:lambda:us-east-2:123456789012:function:example"},
+		{[6]string{"", "lambda", "cn-north-1", "123456789012", "function", "example"}, "arn:aws-cn:lambda:cn-north-1:123456789012:function:example"},
+		{[6]string{"aws-us-gov", "iam", "", "123456789012", "role", "example"}, "arn:aws-us-gov:iam::123456789012:role/example"},
+		{[6]string{"", "s3", "", "", "", "my-bucket"}, "arn:aws:s3:::my-bucket"},
+		{[6]string{"", "sns", "us-east-2", "123456789012", "", "topic"}, "arn:aws:sns:us-east-2:123456789012:topic"},
+		{[6]string{"", "newservice", "", "", "widget", "abc"}, "arn:aws:newservice:::widget/abc"},
+	}
+
+	for _, test := range tests {
+		result, err := buildARN(newTestARN(test.components))
+		if err != nil {
+			t.Fatalf("buildARN(%q) returned error: %v", test.components, err)
+		}
+		if result != test.expected {
+			t.Errorf("buildARN(%q) = %q, expected %q", test.components, result, test.expected)
+		}
+	}
+}
+
+func TestBuildARNInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, components := range [][6]string{
+		{"", "iam", "us-east-2", "123456789012", "role", "example"},
+		{"", "iam", "", "", "role", "example"},
+		{"", "lambda", "", "123456789012", "function", "example"},
+		{"aws", "lambda", "cn-north-1", "123456789012", "function", "example"},
+		{"", "route53", "", "123456789012", "hostedzone", "Z123"},
+		{"", "s3", "", "", "bucket", "my-bucket"},
+		{"", "sqs", "us-east-2", "123456789012", "queue", "example"},
+		{"", "ec2", "us-east-2", "1234", "instance", "i-1"},
+		{"", "ec2", "us-east-2", "123456789012", "instance", ""},
+		{"", "ec2", "nowhere", "123456789012", "instance", "i-1"},
+	} {
+		if _, err := buildARN(newTestARN(components)); err == nil {
+			t.Errorf("buildARN(%q) expected an error", components)
+		}
+	}
+}
+
+func TestAWSPartitionForRegion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"us-east-2":       "aws",
+		"eu-central-1":    "aws",
+		"cn-north-1":      "aws-cn",
+		"cn-northwest-1":  "aws-cn",
+		"us-gov-west-1":   "aws-us-gov",
+		"us-iso-east-1":   "aws-iso",
+		"us-isob-east-1":  "aws-iso-b",
+		"eu-isoe-west-1":  "aws-iso-e",
+		"us-isof-south-1": "aws-iso-f",
+		"eusc-de-east-1":  "aws-eusc",
+	}
+
+	for region, expected := range tests {
+		result, err := awsPartitionForRegion(region)
+		if err != nil {
+			t.Fatalf("awsPartitionForRegion(%q) returned error: %v", region, err)
+		}
+		if result != expected {
+			t.Errorf("awsPartitionForRegion(%q) = %q, expected %q", region, result, expected)
+		}
+	}
+
+	for _, region := range []string{"not a region", "us-east", "xx-east-1", "us-gov-up-1"} {
+		if _, err := awsPartitionForRegion(region); err == nil {
+			t.Errorf("awsPartitionForRegion(%q) expected an error for an invalid region", region)
+		}
+	}
+}
+
+func newTestARN(components [6]string) awsARN {
+	return awsARN{
+		Partition:    optionalStringValue(components[0]),
+		Service:      types.StringValue(components[1]),
+		Region:       optionalStringValue(components[2]),
+		AccountID:    optionalStringValue(components[3]),
+		ResourceType: optionalStringValue(components[4]),
+		ResourceID:   types.StringValue(components[5]),
+	}
+}
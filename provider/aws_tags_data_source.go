@@ -9,21 +9,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 /**************************************************************
   Provider Definition
  **************************************************************/
 
-var _ datasource.DataSource = &awsTagsDataSource{}
+var _ datasource.DataSourceWithConfigure = &awsTagsDataSource{}
+
+// The provider attributes that the tags derive from, other than the region which can be overridden
+var awsTagsProviderAttributes = []string{"environment", "stack_version", "stack_commit", "root_module", "is_local", "extra_tags"}
 
 func NewAWSTagsDataSource() datasource.DataSource {
 	return &awsTagsDataSource{}
 }
 
 type awsTagsDataSource struct {
-	ProviderData *PanfactumProvider
+	panfactumDataSource
 }
 
 type awsLabelsDataSourceModel struct {
@@ -38,8 +43,8 @@ func (d *awsTagsDataSource) Metadata(ctx context.Context, req datasource.Metadat
 
 func (d *awsTagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "Provides the standard set of Panfactum resource tags for AWS resources",
-		MarkdownDescription: "Provides the standard set of Panfactum resource tags for AWS resources",
+		Description:         "Provides the standard set of Panfactum resource tags for AWS resources." + unknownProviderConfigurationDescription,
+		MarkdownDescription: "Provides the standard set of Panfactum resource tags for AWS resources." + unknownProviderConfigurationDescription,
 
 		Attributes: map[string]schema.Attribute{
 			"tags": schema.MapAttribute{
@@ -62,27 +67,13 @@ func (d *awsTagsDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	}
 }
 
-func (d *awsTagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	data, ok := req.ProviderData.(*PanfactumProvider)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PanfactumProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (d *awsTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
+	// Guard against reads before the provider has been configured
+	if !d.checkProviderConfigured(req, resp) {
 		return
 	}
 
-	d.ProviderData = data
-}
-
-func (d *awsTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-
 	var data awsLabelsDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -91,6 +82,15 @@ func (d *awsTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	// The tags derive from the provider configuration, so it must be known
+	providerAttributes := append([]string{}, awsTagsProviderAttributes...)
+	if data.RegionOverride.IsNull() {
+		providerAttributes = append(providerAttributes, "region")
+	}
+	if !d.checkProviderAttributesKnown(req, resp, providerAttributes...) {
+		return
+	}
+
 	tags := map[string]attr.Value{
 		"panfactum.com/local": types.StringValue(d.ProviderData.IsLocal.String()),
 	}
@@ -115,9 +115,10 @@ func (d *awsTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		if ok {
 			setAWSTag(tags, key, strValue)
 		} else {
-			resp.Diagnostics.AddError(
-				"Invalid type found",
-				fmt.Sprintf("Failed to convert value for key '%s' to string.", key),
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tags").AtMapKey(key),
+				"Invalid extra tag",
+				fmt.Sprintf("The value of the provider's extra_tags entry '%s' must be a string.", key),
 			)
 			return
 		}
@@ -125,6 +126,8 @@ func (d *awsTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	data.Tags, _ = types.MapValue(types.StringType, tags)
 
+	tflog.Debug(ctx, "Computed AWS tags", map[string]any{"module": data.Module.ValueString(), "tags": data.Tags.String()})
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestAWSTagsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                provider "pf" {
+                    environment   = "production"
+                    region        = "us-east-2"
+                    root_module   = "aws_eks"
+                    stack_version = "edge.24-10-01"
+                    stack_commit  = "0123456789abcdef"
+                    extra_tags    = {
+                        "Cost Center" = "platform team"
+                    }
+                }
+
+                data "pf_aws_tags" "test" {
+                    module = "vault"
+                }
+
+                data "pf_aws_tags" "override" {
+                    module          = "vault"
+                    region_override = "eu-central-1"
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_aws_tags.test", tfjsonpath.New("tags"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"panfactum.com/local":         knownvalue.StringExact("<null>"),
+						"panfactum.com/environment":   knownvalue.StringExact("production"),
+						"panfactum.com/region":        knownvalue.StringExact("us-east-2"),
+						"panfactum.com/stack-version": knownvalue.StringExact("edge.24-10-01"),
+						"panfactum.com/stack-commit":  knownvalue.StringExact("0123456789abcdef"),
+						"panfactum.com/root-module":   knownvalue.StringExact("aws_eks"),
+						"panfactum.com/module":        knownvalue.StringExact("vault"),
+						"Cost.Center":                 knownvalue.StringExact("platform.team"),
+					})),
+					statecheck.ExpectKnownValue("data.pf_aws_tags.override", tfjsonpath.New("tags").AtMapKey("panfactum.com/region"), knownvalue.StringExact("eu-central-1")),
+				},
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"net"
 )
 
@@ -56,15 +57,18 @@ func (f CIDRContainsFunction) Run(ctx context.Context, req function.RunRequest,
 
 	ip := net.ParseIP(ipStr)
 	if ip == nil {
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Invalid IP address: %s\n", ipStr)))
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("Invalid IP address: %s\n", ipStr)))
 		return
 	}
 
 	_, cidrNet, err := net.ParseCIDR(cidrStr)
 	if err != nil {
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Invalid CIDR block: %s\n", cidrStr)))
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid CIDR block: %s\n", cidrStr)))
 		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cidrNet.Contains(ip)))
+	contains := cidrNet.Contains(ip)
+	tflog.Debug(ctx, "Checked CIDR membership", map[string]any{"cidr": cidrStr, "ip": ipStr, "contains": contains})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, contains))
 }
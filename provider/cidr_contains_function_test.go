@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"regexp"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestCIDRContainsFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                output "contains" {
+                    value = provider::pf::cidr_contains("10.0.0.0/16", "10.0.255.255")
+                }
+
+                output "not_contains" {
+                    value = provider::pf::cidr_contains("10.0.0.0/16", "10.1.0.0")
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("contains", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("not_contains", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: `
+                output "test" {
+                    value = provider::pf::cidr_contains("10.0.0.0/16", "not-an-ip")
+                }`,
+				ExpectError: regexp.MustCompile(`Invalid IP address: not-an-ip`),
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"net"
 )
 
@@ -48,15 +49,19 @@ func (f CIDRCountHosts) Run(ctx context.Context, req function.RunRequest, resp *
 
 	}
 
-	_, cidrNet, err := net.ParseCIDR(cidrStr)
+	cidrNet, err := parseIPv4CIDR(cidrStr)
 	if err != nil {
-		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Invalid CIDR block: %s\n", cidrStr)))
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%s\n", err)))
 		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, countHosts(cidrNet)))
+	hosts := countHosts(cidrNet)
+	tflog.Debug(ctx, "Counted CIDR hosts", map[string]any{"cidr": cidrStr, "hosts": hosts})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hosts))
 }
 
+// countHosts returns the number of usable host addresses in an IPv4 network
 func countHosts(cidrNet *net.IPNet) int64 {
 	maskOnes, _ := cidrNet.Mask.Size()
 	hostBits := 32 - maskOnes
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestCIDRCountHostsFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                output "slash_16" {
+                    value = provider::pf::cidr_count_hosts("10.0.0.0/16")
+                }
+
+                output "slash_32" {
+                    value = provider::pf::cidr_count_hosts("10.0.0.1/32")
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("slash_16", knownvalue.Int64Exact(65534)),
+					statecheck.ExpectKnownOutputValue("slash_32", knownvalue.Int64Exact(1)),
+				},
+			},
+		},
+	})
+}
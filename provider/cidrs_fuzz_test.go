@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/binary"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+	"testing"
+)
+
+var cidrFuzzSeeds = []string{
+	"10.0.0.0/8",
+	"10.0.0.0/16",
+	"10.0.0.5/32",
+	"10.0.0.0/31",
+	"0.0.0.0/0",
+	"192.168.1.1/24",
+	"2001:db8::/32",
+	"::/0",
+	"::ffff:10.0.0.0/104",
+	"10.0.0.0",
+	"10.0.0.0/33",
+	"",
+}
+
+// fuzzIPv4Network builds an IPv4 network from arbitrary fuzz input
+func fuzzIPv4Network(address uint32, ones uint8) *net.IPNet {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, address)
+	mask := net.CIDRMask(int(ones)%33, 8*net.IPv4len)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+func FuzzCountHosts(f *testing.F) {
+	f.Add(uint32(0x0A000000), uint8(8))
+	f.Add(uint32(0), uint8(0))
+	f.Add(uint32(0xFFFFFFFF), uint8(32))
+	f.Add(uint32(0x0A000000), uint8(31))
+	f.Fuzz(func(t *testing.T, address uint32, ones uint8) {
+		network := fuzzIPv4Network(address, ones)
+		maskOnes, _ := network.Mask.Size()
+
+		hosts := countHosts(network)
+		total := int64(1) << (32 - maskOnes)
+		if hosts < 1 || hosts > total {
+			t.Errorf("countHosts(%s) = %d, expected between 1 and %d", network, hosts, total)
+		}
+		if maskOnes < 31 && hosts != total-2 {
+			t.Errorf("countHosts(%s) = %d, expected %d", network, hosts, total-2)
+		}
+	})
+}
+
+func FuzzNetworkRange(f *testing.F) {
+	f.Add(uint32(0x0A000000), uint8(8))
+	f.Add(uint32(0), uint8(0))
+	f.Add(uint32(0xFFFFFFFF), uint8(32))
+	f.Add(uint32(0xC0A80101), uint8(24))
+	f.Fuzz(func(t *testing.T, address uint32, ones uint8) {
+		network := fuzzIPv4Network(address, ones)
+		maskOnes, _ := network.Mask.Size()
+
+		start, end := networkRange(network)
+		if start > end {
+			t.Fatalf("networkRange(%s) = %s - %s starts after it ends", network, uint32ToIP(start), uint32ToIP(end))
+		}
+		if size := uint64(end-start) + 1; size != uint64(1)<<(32-maskOnes) {
+			t.Errorf("networkRange(%s) contains %d addresses, expected %d", network, size, uint64(1)<<(32-maskOnes))
+		}
+		if !network.Contains(net.ParseIP(uint32ToIP(start))) || !network.Contains(net.ParseIP(uint32ToIP(end))) {
+			t.Errorf("networkRange(%s) = %s - %s is not within the network", network, uint32ToIP(start), uint32ToIP(end))
+		}
+		if start > 0 && network.Contains(net.ParseIP(uint32ToIP(start-1))) {
+			t.Errorf("networkRange(%s) does not start at the network address", network)
+		}
+		if end < 0xFFFFFFFF && network.Contains(net.ParseIP(uint32ToIP(end+1))) {
+			t.Errorf("networkRange(%s) does not end at the broadcast address", network)
+		}
+	})
+}
+
+func FuzzCIDRsOverlap(f *testing.F) {
+	for _, a := range cidrFuzzSeeds {
+		for _, b := range cidrFuzzSeeds[:4] {
+			f.Add(a, b)
+		}
+	}
+	f.Fuzz(func(t *testing.T, a string, b string) {
+		result, err := runTestFunction(t, NewCIDRsOverlapFunction(), types.ListValueMust(types.StringType, []attr.Value{types.StringValue(a), types.StringValue(b)}))
+
+		networkA, errA := parseIPv4CIDR(a)
+		networkB, errB := parseIPv4CIDR(b)
+		if errA != nil || errB != nil {
+			if err == nil {
+				t.Errorf("cidrs_overlap([%q, %q]) = %s, expected an error", a, b, result)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("cidrs_overlap([%q, %q]) returned an error: %v", a, b, err)
+		}
+
+		expected := networkA.Contains(networkB.IP) || networkB.Contains(networkA.IP)
+		if !result.Equal(types.BoolValue(expected)) {
+			t.Errorf("cidrs_overlap([%q, %q]) = %s, expected %t", a, b, result, expected)
+		}
+		if AnyCIDRsOverlap([]*net.IPNet{networkA, networkB}) != AnyCIDRsOverlap([]*net.IPNet{networkB, networkA}) {
+			t.Errorf("cidrs_overlap([%q, %q]) is not symmetric", a, b)
+		}
+	})
+}
+
+func FuzzCIDRCountHosts(f *testing.F) {
+	for _, seed := range cidrFuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, cidr string) {
+		result, err := runTestFunction(t, NewCIDRCountHosts(), types.StringValue(cidr))
+		if _, parseErr := parseIPv4CIDR(cidr); parseErr != nil {
+			if err == nil {
+				t.Errorf("cidr_count_hosts(%q) = %s, expected an error", cidr, result)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("cidr_count_hosts(%q) returned an error: %v", cidr, err)
+		}
+		if hosts, ok := result.(types.Int64); !ok || hosts.ValueInt64() < 1 {
+			t.Errorf("cidr_count_hosts(%q) = %s, expected a positive number", cidr, result)
+		}
+	})
+}
+
+func FuzzCIDRContains(f *testing.F) {
+	for _, seed := range cidrFuzzSeeds {
+		f.Add(seed, "10.0.0.1")
+		f.Add(seed, "2001:db8::1")
+	}
+	f.Fuzz(func(t *testing.T, cidr string, ip string) {
+		result, err := runTestFunction(t, NewCIDRContainsFunction(), types.StringValue(cidr), types.StringValue(ip))
+
+		_, network, parseErr := net.ParseCIDR(cidr)
+		address := net.ParseIP(ip)
+		if parseErr != nil || address == nil {
+			if err == nil {
+				t.Errorf("cidr_contains(%q, %q) = %s, expected an error", cidr, ip, result)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("cidr_contains(%q, %q) returned an error: %v", cidr, ip, err)
+		}
+		if !result.Equal(types.BoolValue(network.Contains(address))) {
+			t.Errorf("cidr_contains(%q, %q) = %s, expected %t", cidr, ip, result, network.Contains(address))
+		}
+	})
+}
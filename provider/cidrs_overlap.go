@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"net"
 	"sort"
 )
@@ -55,15 +56,18 @@ func (f CIDRsOverlapFunction) Run(ctx context.Context, req function.RunRequest,
 	var cidrs []*net.IPNet
 
 	for _, s := range cidrStrs {
-		_, cidrNet, err := net.ParseCIDR(s)
+		cidrNet, err := parseIPv4CIDR(s)
 		if err != nil {
-			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Invalid CIDR block: %s\n", s)))
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%s\n", err)))
 			return
 		}
 		cidrs = append(cidrs, cidrNet)
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, AnyCIDRsOverlap(cidrs)))
+	overlap := AnyCIDRsOverlap(cidrs)
+	tflog.Debug(ctx, "Checked CIDR overlap", map[string]any{"cidrs": cidrStrs, "overlap": overlap})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, overlap))
 }
 
 type ipRange struct {
@@ -71,6 +75,7 @@ type ipRange struct {
 	end   uint32
 }
 
+// AnyCIDRsOverlap returns true if any two of the IPv4 networks overlap
 func AnyCIDRsOverlap(cidrs []*net.IPNet) bool {
 	var ranges []ipRange
 
@@ -95,7 +100,20 @@ func AnyCIDRsOverlap(cidrs []*net.IPNet) bool {
 	return false
 }
 
-// networkRange returns the start and end addresses of a CIDR as uint32
+// parseIPv4CIDR parses an IPv4 CIDR block. The CIDR math in this package works on 32-bit
+// addresses, so IPv6 blocks are rejected instead of being miscounted.
+func parseIPv4CIDR(s string) (*net.IPNet, error) {
+	_, cidrNet, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid CIDR block: %s", s)
+	}
+	if _, bits := cidrNet.Mask.Size(); bits != 8*net.IPv4len {
+		return nil, fmt.Errorf("Invalid CIDR block: %s is not an IPv4 CIDR block", s)
+	}
+	return cidrNet, nil
+}
+
+// networkRange returns the start and end addresses of an IPv4 CIDR as uint32
 func networkRange(network *net.IPNet) (uint32, uint32) {
 	ip := network.IP.To4()
 	maskOnes, _ := network.Mask.Size()
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+	"testing"
+)
+
+func TestCIDRContains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cidr     string
+		ip       string
+		expected bool
+	}{
+		{"10.0.0.0/8", "10.1.2.3", true},
+		{"10.0.0.0/8", "11.0.0.1", false},
+		{"10.0.0.0/24", "10.0.0.0", true},
+		{"10.0.0.0/24", "10.0.0.255", true},
+		{"10.0.0.0/24", "10.0.1.0", false},
+		{"10.0.0.5/32", "10.0.0.5", true},
+		{"10.0.0.5/32", "10.0.0.6", false},
+		{"0.0.0.0/0", "192.168.1.1", true},
+		// The host bits of the CIDR block are ignored
+		{"10.1.2.3/8", "10.200.0.1", true},
+	}
+
+	for _, test := range tests {
+		result, err := runTestFunction(t, NewCIDRContainsFunction(), types.StringValue(test.cidr), types.StringValue(test.ip))
+		if err != nil {
+			t.Errorf("cidr_contains(%q, %q) returned an error: %v", test.cidr, test.ip, err)
+		} else if !result.Equal(types.BoolValue(test.expected)) {
+			t.Errorf("cidr_contains(%q, %q) = %s, expected %t", test.cidr, test.ip, result, test.expected)
+		}
+	}
+}
+
+func TestCountHosts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cidr     string
+		expected int64
+	}{
+		{"0.0.0.0/0", 4294967294},
+		{"10.0.0.0/8", 16777214},
+		{"10.0.0.0/16", 65534},
+		{"10.0.0.0/24", 254},
+		{"10.0.0.0/29", 6},
+		{"10.0.0.0/30", 2},
+		{"10.0.0.0/31", 2},
+		{"10.0.0.0/32", 1},
+	}
+
+	for _, test := range tests {
+		_, cidrNet, err := net.ParseCIDR(test.cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := countHosts(cidrNet); actual != test.expected {
+			t.Errorf("countHosts(%s) = %d, expected %d", test.cidr, actual, test.expected)
+		}
+	}
+}
+
+func TestNetworkRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cidr  string
+		start string
+		end   string
+	}{
+		{"10.0.0.0/8", "10.0.0.0", "10.255.255.255"},
+		{"10.1.2.3/16", "10.1.0.0", "10.1.255.255"},
+		{"192.168.1.0/24", "192.168.1.0", "192.168.1.255"},
+		{"192.168.1.7/32", "192.168.1.7", "192.168.1.7"},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255"},
+	}
+
+	for _, test := range tests {
+		_, cidrNet, err := net.ParseCIDR(test.cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		start, end := networkRange(cidrNet)
+		if actualStart, actualEnd := uint32ToIP(start), uint32ToIP(end); actualStart != test.start || actualEnd != test.end {
+			t.Errorf("networkRange(%s) = %s - %s, expected %s - %s", test.cidr, actualStart, actualEnd, test.start, test.end)
+		}
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cidrs    []string
+		expected bool
+	}{
+		{[]string{}, false},
+		{[]string{"10.0.0.0/16"}, false},
+		{[]string{"10.0.0.0/16", "10.1.0.0/16"}, false},
+		{[]string{"10.0.0.0/16", "10.0.0.0/16"}, true},
+		{[]string{"10.0.0.0/8", "10.20.0.0/16"}, true},
+		{[]string{"10.20.0.0/16", "10.0.0.0/8"}, true},
+		{[]string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"}, false},
+		{[]string{"10.0.2.0/24", "10.0.0.0/24", "10.0.1.0/23"}, true},
+		{[]string{"0.0.0.0/0", "192.168.0.0/16"}, true},
+	}
+
+	for _, test := range tests {
+		elements := make([]attr.Value, 0, len(test.cidrs))
+		for _, cidr := range test.cidrs {
+			elements = append(elements, types.StringValue(cidr))
+		}
+
+		result, err := runTestFunction(t, NewCIDRsOverlapFunction(), types.ListValueMust(types.StringType, elements))
+		if err != nil {
+			t.Errorf("cidrs_overlap(%q) returned an error: %v", test.cidrs, err)
+		} else if !result.Equal(types.BoolValue(test.expected)) {
+			t.Errorf("cidrs_overlap(%q) = %s, expected %t", test.cidrs, result, test.expected)
+		}
+	}
+}
+
+func uint32ToIP(value uint32) string {
+	return net.IPv4(byte(value>>24), byte(value>>16), byte(value>>8), byte(value)).String()
+}
@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Allows TZ= prefixes to be evaluated on systems without a zoneinfo database
+)
+
+// This file implements the cron dialects used across Panfactum modules:
+//
+//	standard:   5-field Vixie cron (minute hour day-of-month month day-of-week)
+//	kubernetes: standard, plus @-macros (e.g., @hourly), @every <duration>, and TZ= / CRON_TZ= prefixes
+//	aws:        EventBridge cron(minute hour day-of-month month day-of-week year) and rate(value unit)
+
+const (
+	cronDialectStandard   = "standard"
+	cronDialectKubernetes = "kubernetes"
+	cronDialectAWS        = "aws"
+)
+
+var cronDialects = []string{cronDialectStandard, cronDialectKubernetes, cronDialectAWS}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var cronWeekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+var (
+	cronAWSExprRegex = regexp.MustCompile(`^cron\((.*)\)$`)
+	cronAWSRateRegex = regexp.MustCompile(`^rate\(\s*([0-9]+)\s+([a-z]+)\s*\)$`)
+)
+
+// cronMaxSearchYears bounds how far into the future the next run time is searched for.
+// 28 years is the period of the Gregorian calendar's weekday / leap year cycle.
+const cronMaxSearchYears = 28
+
+type cronSchedule interface {
+	// next returns the first run time strictly after t, or the zero time if there is none
+	next(t time.Time) time.Time
+}
+
+// cronIntervalSchedule runs at fixed intervals from the reference time
+type cronIntervalSchedule struct {
+	interval time.Duration
+}
+
+func (s cronIntervalSchedule) next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// cronSpecSchedule runs at the times that match every field
+type cronSpecSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+
+	// years is nil if every year matches
+	years map[int]bool
+
+	// domSpecial and dowSpecial hold the AWS-only day expressions (L, W, #)
+	domSpecial *cronDaySpecial
+	dowSpecial *cronDaySpecial
+
+	location *time.Location
+}
+
+type cronDaySpecial struct {
+	kind string // "last", "last-weekday", "nearest-weekday", "last-dow", "nth-dow"
+	n    int    // The day of the month or the day of the week
+	k    int    // The occurrence of the day of the week for nth-dow
+}
+
+// parseCron parses the expression according to the rules of the dialect
+func parseCron(expr string, dialect string) (cronSchedule, error) {
+	switch dialect {
+	case cronDialectStandard:
+		return parseCronStandard(expr, time.UTC)
+	case cronDialectKubernetes:
+		return parseCronKubernetes(expr)
+	case cronDialectAWS:
+		return parseCronAWS(expr)
+	default:
+		return nil, fmt.Errorf("unsupported dialect '%s'; must be one of: %s", dialect, strings.Join(cronDialects, ", "))
+	}
+}
+
+// detectCronDialect returns aws for cron() and rate() expressions and kubernetes otherwise,
+// as the kubernetes dialect is a superset of the standard dialect
+func detectCronDialect(expr string) string {
+	trimmed := strings.TrimSpace(expr)
+	if strings.HasPrefix(trimmed, "cron(") || strings.HasPrefix(trimmed, "rate(") {
+		return cronDialectAWS
+	}
+	return cronDialectKubernetes
+}
+
+func parseCronKubernetes(expr string) (cronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	location := time.UTC
+
+	if strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=") {
+		tz, rest, found := strings.Cut(expr, " ")
+		if !found {
+			return nil, fmt.Errorf("expression '%s' has a time zone but no schedule", expr)
+		}
+		_, name, _ := strings.Cut(tz, "=")
+		loaded, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone '%s'", name)
+		}
+		location = loaded
+		expr = strings.TrimSpace(rest)
+	}
+
+	if strings.HasPrefix(expr, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expr, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every duration: %v", err)
+		}
+		if interval < time.Second {
+			return nil, fmt.Errorf("@every duration must be at least 1s")
+		}
+		return cronIntervalSchedule{interval: interval}, nil
+	}
+
+	if strings.HasPrefix(expr, "@") {
+		standard, ok := cronMacros[expr]
+		if !ok {
+			return nil, fmt.Errorf("unknown macro '%s'", expr)
+		}
+		expr = standard
+	}
+
+	return parseCronStandard(expr, location)
+}
+
+func parseCronStandard(expr string, location *time.Location) (cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+
+	schedule := cronSpecSchedule{location: location}
+	var err error
+
+	if schedule.minute, _, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %v", err)
+	}
+	if schedule.hour, _, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %v", err)
+	}
+	if schedule.dom, schedule.domStar, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid day-of-month field: %v", err)
+	}
+	if schedule.month, _, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid month field: %v", err)
+	}
+	if schedule.dow, schedule.dowStar, err = parseCronField(fields[4], 0, 7, cronWeekdayNames); err != nil {
+		return nil, fmt.Errorf("invalid day-of-week field: %v", err)
+	}
+
+	// Both 0 and 7 are Sunday
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow = schedule.dow&^(1<<7) | 1
+	}
+
+	return &schedule, nil
+}
+
+func parseCronAWS(expr string) (cronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	if match := cronAWSRateRegex.FindStringSubmatch(expr); match != nil {
+		value, err := strconv.Atoi(match[1])
+		if err != nil || value < 1 {
+			return nil, fmt.Errorf("rate value must be a positive integer")
+		}
+		singular := map[string]time.Duration{"minute": time.Minute, "hour": time.Hour, "day": 24 * time.Hour}
+		unit := match[2]
+		if value == 1 {
+			if _, ok := singular[unit]; !ok {
+				return nil, fmt.Errorf("rate unit must be one of: minute, hour, day when the value is 1")
+			}
+		} else {
+			if !strings.HasSuffix(unit, "s") {
+				return nil, fmt.Errorf("rate unit must be one of: minutes, hours, days when the value is greater than 1")
+			}
+			unit = strings.TrimSuffix(unit, "s")
+			if _, ok := singular[unit]; !ok {
+				return nil, fmt.Errorf("rate unit must be one of: minutes, hours, days when the value is greater than 1")
+			}
+		}
+		return cronIntervalSchedule{interval: time.Duration(value) * singular[unit]}, nil
+	}
+
+	match := cronAWSExprRegex.FindStringSubmatch(expr)
+	if match == nil {
+		return nil, fmt.Errorf("expected cron(...) or rate(...)")
+	}
+
+	fields := strings.Fields(match[1])
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("expected 6 fields (minute hour day-of-month month day-of-week year), got %d", len(fields))
+	}
+
+	schedule := cronSpecSchedule{location: time.UTC}
+	var err error
+
+	if schedule.minute, _, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %v", err)
+	}
+	if schedule.hour, _, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %v", err)
+	}
+	if schedule.month, _, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid month field: %v", err)
+	}
+
+	// Exactly one of the day-of-month and day-of-week fields must be '?'
+	domAny, dowAny := fields[2] == "?", fields[4] == "?"
+	if domAny == dowAny {
+		return nil, fmt.Errorf("exactly one of the day-of-month and day-of-week fields must be '?'")
+	}
+
+	if domAny {
+		schedule.dom, schedule.domStar = parseCronBits(1, 31), true
+	} else if schedule.domSpecial, err = parseCronAWSDomSpecial(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid day-of-month field: %v", err)
+	} else if schedule.domSpecial == nil {
+		if schedule.dom, schedule.domStar, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+			return nil, fmt.Errorf("invalid day-of-month field: %v", err)
+		}
+	}
+
+	// AWS numbers the days of the week from 1 (SUN) to 7 (SAT)
+	awsWeekdayNames := map[string]int{}
+	for name, day := range cronWeekdayNames {
+		awsWeekdayNames[name] = day + 1
+	}
+
+	if dowAny {
+		schedule.dow, schedule.dowStar = parseCronBits(0, 6), true
+	} else if schedule.dowSpecial, err = parseCronAWSDowSpecial(fields[4], awsWeekdayNames); err != nil {
+		return nil, fmt.Errorf("invalid day-of-week field: %v", err)
+	} else if schedule.dowSpecial == nil {
+		if schedule.dow, schedule.dowStar, err = parseCronField(fields[4], 1, 7, awsWeekdayNames); err != nil {
+			return nil, fmt.Errorf("invalid day-of-week field: %v", err)
+		}
+		schedule.dow >>= 1
+	}
+
+	// When one day field is '?', only the other one constrains the schedule
+	schedule.domStar, schedule.dowStar = domAny, dowAny
+
+	if fields[5] != "*" {
+		years, err := parseCronYears(fields[5])
+		if err != nil {
+			return nil, fmt.Errorf("invalid year field: %v", err)
+		}
+		schedule.years = years
+	}
+
+	return &schedule, nil
+}
+
+func parseCronAWSDomSpecial(field string) (*cronDaySpecial, error) {
+	switch {
+	case field == "L":
+		return &cronDaySpecial{kind: "last"}, nil
+	case field == "LW":
+		return &cronDaySpecial{kind: "last-weekday"}, nil
+	case strings.HasSuffix(field, "W"):
+		day, err := strconv.Atoi(strings.TrimSuffix(field, "W"))
+		if err != nil || day < 1 || day > 31 {
+			return nil, fmt.Errorf("'%s' must be a day of the month between 1 and 31 followed by W", field)
+		}
+		return &cronDaySpecial{kind: "nearest-weekday", n: day}, nil
+	}
+	return nil, nil
+}
+
+func parseCronAWSDowSpecial(field string, names map[string]int) (*cronDaySpecial, error) {
+	parseDay := func(value string) (int, error) {
+		if day, ok := names[strings.ToLower(value)]; ok {
+			return day - 1, nil
+		}
+		day, err := strconv.Atoi(value)
+		if err != nil || day < 1 || day > 7 {
+			return 0, fmt.Errorf("'%s' is not a day of the week between 1 and 7", value)
+		}
+		return day - 1, nil
+	}
+
+	switch {
+	case field == "L":
+		return &cronDaySpecial{kind: "last-dow", n: 6}, nil
+	case strings.HasSuffix(field, "L"):
+		day, err := parseDay(strings.TrimSuffix(field, "L"))
+		if err != nil {
+			return nil, err
+		}
+		return &cronDaySpecial{kind: "last-dow", n: day}, nil
+	case strings.Contains(field, "#"):
+		dayStr, nthStr, _ := strings.Cut(field, "#")
+		day, err := parseDay(dayStr)
+		if err != nil {
+			return nil, err
+		}
+		nth, err := strconv.Atoi(nthStr)
+		if err != nil || nth < 1 || nth > 5 {
+			return nil, fmt.Errorf("'%s' must be followed by an occurrence between 1 and 5", field)
+		}
+		return &cronDaySpecial{kind: "nth-dow", n: day, k: nth}, nil
+	}
+	return nil, nil
+}
+
+func parseCronYears(field string) (map[int]bool, error) {
+	years := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		start, end, step, err := parseCronRange(part, 1970, 2199, nil)
+		if err != nil {
+			return nil, err
+		}
+		for year := start; year <= end; year += step {
+			years[year] = true
+		}
+	}
+	return years, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges (a-b), and steps (*/n or a-b/n)
+// into a bitset. The returned bool is true if the field starts with '*', which is how Vixie cron
+// decides whether a day field is unrestricted, so */2 is unrestricted but 1-31 is not.
+func parseCronField(field string, min, max int, names map[string]int) (uint64, bool, error) {
+	var result uint64
+	star := strings.HasPrefix(field, "*")
+
+	for _, part := range strings.Split(field, ",") {
+		start, end, step, err := parseCronRange(part, min, max, names)
+		if err != nil {
+			return 0, false, err
+		}
+		for value := start; value <= end; value += step {
+			result |= 1 << uint(value)
+		}
+	}
+
+	return result, star, nil
+}
+
+func parseCronRange(part string, min, max int, names map[string]int) (int, int, int, error) {
+	if part == "" {
+		return 0, 0, 0, fmt.Errorf("empty value")
+	}
+
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step < 1 {
+			return 0, 0, 0, fmt.Errorf("step '%s' must be a positive integer", stepPart)
+		}
+	}
+
+	var start, end int
+	if rangePart == "*" {
+		start, end = min, max
+	} else {
+		startPart, endPart, isRange := strings.Cut(rangePart, "-")
+		var err error
+		if start, err = parseCronValue(startPart, min, max, names); err != nil {
+			return 0, 0, 0, err
+		}
+		switch {
+		case isRange:
+			if end, err = parseCronValue(endPart, min, max, names); err != nil {
+				return 0, 0, 0, err
+			}
+			if end < start {
+				return 0, 0, 0, fmt.Errorf("range '%s' ends before it starts", rangePart)
+			}
+		case hasStep:
+			// a/n is shorthand for a-max/n
+			end = max
+		default:
+			end = start
+		}
+	}
+
+	return start, end, step, nil
+}
+
+func parseCronValue(value string, min, max int, names map[string]int) (int, error) {
+	if named, ok := names[strings.ToLower(value)]; ok {
+		return named, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a number", value)
+	}
+	if parsed < min || parsed > max {
+		return 0, fmt.Errorf("'%s' is not between %d and %d", value, min, max)
+	}
+	return parsed, nil
+}
+
+func parseCronBits(min, max int) uint64 {
+	var result uint64
+	for value := min; value <= max; value++ {
+		result |= 1 << uint(value)
+	}
+	return result
+}
+
+// next searches the calendar dates from the local date of t for one that matches the day
+// fields, then returns the earliest matching time of day on it that is after t. Every iteration
+// advances the date, so the search ends after at most cronMaxSearchYears of dates (or the last
+// year of an AWS year field).
+//
+// Local times that are skipped when the clocks go forward for daylight saving time do not run,
+// and local times that are repeated when the clocks go back run once, at their first occurrence.
+func (s *cronSpecSchedule) next(t time.Time) time.Time {
+	t = t.In(s.location)
+	yearLimit := t.Year() + cronMaxSearchYears
+	for year := range s.years {
+		if year > yearLimit {
+			yearLimit = year
+		}
+	}
+
+	// Dates are searched in UTC so that the date arithmetic is not affected by DST
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	for date.Year() <= yearLimit {
+		switch {
+		case s.years != nil && !s.years[date.Year()]:
+			date = time.Date(date.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		case s.month&(1<<uint(date.Month())) == 0:
+			date = time.Date(date.Year(), date.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(date):
+			date = date.AddDate(0, 0, 1)
+		default:
+			if next, ok := s.nextOnDate(date, t); ok {
+				return next
+			}
+			date = date.AddDate(0, 0, 1)
+		}
+	}
+
+	return time.Time{}
+}
+
+// nextOnDate returns the first time on the date that matches the hour and minute fields and
+// is after t. The local times are visited in order, and as skipped local times are not run and
+// repeated local times run at their first occurrence, they are also in order of absolute time.
+func (s *cronSpecSchedule) nextOnDate(date time.Time, t time.Time) (time.Time, bool) {
+	for hour := 0; hour < 24; hour++ {
+		if s.hour&(1<<uint(hour)) == 0 {
+			continue
+		}
+		for minute := 0; minute < 60; minute++ {
+			if s.minute&(1<<uint(minute)) == 0 {
+				continue
+			}
+			if local, ok := cronLocalTime(date, hour, minute, s.location); ok && local.After(t) {
+				return local, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// cronLocalTime returns the first instant at which the clocks in the location show the given
+// time on the date, or false if the clocks skip over it
+func cronLocalTime(date time.Time, hour int, minute int, location *time.Location) (time.Time, bool) {
+	isLocalTime := func(t time.Time) bool {
+		return t.Day() == date.Day() && t.Hour() == hour && t.Minute() == minute
+	}
+
+	local := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, location)
+	if !isLocalTime(local) {
+		return time.Time{}, false
+	}
+
+	// time.Date may return either occurrence of a repeated local time, so check whether
+	// the clocks showed the same time before being set back
+	for _, shift := range []time.Duration{2 * time.Hour, time.Hour, 30 * time.Minute} {
+		if earlier := local.Add(-shift); isLocalTime(earlier) {
+			return earlier, true
+		}
+	}
+	return local, true
+}
+
+func (s *cronSpecSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	if s.domSpecial != nil {
+		domMatch = s.domSpecial.matches(t)
+	}
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.dowSpecial != nil {
+		dowMatch = s.dowSpecial.matches(t)
+	}
+
+	// If either field is unrestricted, both must match. Otherwise, either may match.
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func (d *cronDaySpecial) matches(t time.Time) bool {
+	lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+
+	switch d.kind {
+	case "last":
+		return t.Day() == lastDay
+	case "last-weekday":
+		return t.Day() == nearestWeekday(t, lastDay, lastDay)
+	case "nearest-weekday":
+		if d.n > lastDay {
+			return false
+		}
+		return t.Day() == nearestWeekday(t, d.n, lastDay)
+	case "last-dow":
+		return int(t.Weekday()) == d.n && t.Day()+7 > lastDay
+	case "nth-dow":
+		return int(t.Weekday()) == d.n && (t.Day()-1)/7+1 == d.k
+	default:
+		return false
+	}
+}
+
+// nearestWeekday returns the weekday closest to the given day of the month
+// without crossing into another month
+func nearestWeekday(t time.Time, day int, lastDay int) int {
+	weekday := time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday()
+	switch weekday {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
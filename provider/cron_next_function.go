@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"time"
+)
+
+var (
+	_ function.Function = CronNextFunction{}
+)
+
+func NewCronNextFunction() function.Function {
+	return CronNextFunction{}
+}
+
+type CronNextFunction struct{}
+
+const cronNextMaxCount = 1000
+
+func (f CronNextFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next"
+}
+
+func (f CronNextFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the next run times of a cron expression as RFC3339 timestamps",
+		Description: "AWS cron() and rate() expressions are evaluated in UTC. All other expressions are evaluated using the kubernetes dialect in UTC unless they have a TZ= prefix. Interval schedules (rate() and @every) are evaluated relative to the from timestamp. In a TZ= time zone, local times skipped by a daylight saving time change do not run, and repeated local times run once.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The cron expression",
+				Name:               "expr",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The RFC3339 timestamp after which to find run times (e.g., the output of timestamp())",
+				Name:               "from",
+			},
+			function.Int64Parameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        fmt.Sprintf("The number of run times to return (at most %d)", cronNextMaxCount),
+				Name:               "count",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f CronNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expr, fromStr string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expr, &fromStr, &count))
+	if resp.Error != nil {
+		return
+
+	}
+
+	schedule, err := parseCron(expr, detectCronDialect(expr))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid cron expression '%s': %v\n", expr, err)))
+		return
+	}
+
+	from, err := time.Parse(time.RFC3339, fromStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("Invalid RFC3339 timestamp: %s\n", fromStr)))
+		return
+	}
+
+	if count < 0 || count > cronNextMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("Count must be between 0 and %d, got %d\n", cronNextMaxCount, count)))
+		return
+	}
+
+	runs := make([]string, 0, count)
+	for t := from; int64(len(runs)) < count; {
+		if t = schedule.next(t); t.IsZero() {
+			break
+		}
+		runs = append(runs, t.Format(time.RFC3339))
+	}
+
+	tflog.Debug(ctx, "Computed cron runs", map[string]any{"expression": expr, "from": fromStr, "count": len(runs)})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, runs))
+}
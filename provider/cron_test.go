@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseCron_Valid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr    string
+		dialect string
+	}{
+		{"*/5 * * * *", cronDialectStandard},
+		{"0 0 1,15 * MON-FRI", cronDialectStandard},
+		{"30 2 * jan,jul sun", cronDialectStandard},
+		{"0 0 * * 7", cronDialectStandard},
+		{"5/15 * * * *", cronDialectStandard},
+		{"@hourly", cronDialectKubernetes},
+		{"@every 1h30m", cronDialectKubernetes},
+		{"TZ=America/New_York 0 9 * * 1-5", cronDialectKubernetes},
+		{"CRON_TZ=UTC @daily", cronDialectKubernetes},
+		{"cron(0 12 * * ? *)", cronDialectAWS},
+		{"cron(15 10 ? * 6L 2022-2030)", cronDialectAWS},
+		{"cron(0 8 1W * ? *)", cronDialectAWS},
+		{"cron(0 8 ? * MON#2 *)", cronDialectAWS},
+		{"cron(0/10 * L * ? *)", cronDialectAWS},
+		{"rate(1 minute)", cronDialectAWS},
+		{"rate(5 hours)", cronDialectAWS},
+	}
+
+	for _, test := range tests {
+		if _, err := parseCron(test.expr, test.dialect); err != nil {
+			t.Errorf("%s (%s): unexpected error: %v", test.expr, test.dialect, err)
+		}
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr    string
+		dialect string
+	}{
+		{"* * * *", cronDialectStandard},
+		{"60 * * * *", cronDialectStandard},
+		{"* 24 * * *", cronDialectStandard},
+		{"* * 0 * *", cronDialectStandard},
+		{"* * * 13 *", cronDialectStandard},
+		{"* * * * 8", cronDialectStandard},
+		{"5-1 * * * *", cronDialectStandard},
+		{"*/0 * * * *", cronDialectStandard},
+		{"@hourly", cronDialectStandard},
+		{"@fortnightly", cronDialectKubernetes},
+		{"TZ=Not/AZone 0 * * * *", cronDialectKubernetes},
+		{"cron(0 12 * * * *)", cronDialectAWS},
+		{"cron(0 12 ? * ? *)", cronDialectAWS},
+		{"cron(0 12 * * ?)", cronDialectAWS},
+		{"cron(0 12 * * ? 1969)", cronDialectAWS},
+		{"cron(0 12 ? * 0 *)", cronDialectAWS},
+		{"rate(1 minutes)", cronDialectAWS},
+		{"rate(5 hour)", cronDialectAWS},
+		{"rate(0 days)", cronDialectAWS},
+		{"0 12 * * ? *", cronDialectAWS},
+		{"0 0 * * *", "quartz"},
+	}
+
+	for _, test := range tests {
+		if _, err := parseCron(test.expr, test.dialect); err == nil {
+			t.Errorf("%s (%s): expected an error", test.expr, test.dialect)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, time.January, 31, 10, 30, 0, 0, time.UTC) // A Wednesday
+
+	tests := []struct {
+		expr     string
+		expected []string
+	}{
+		{"*/15 * * * *", []string{"2024-01-31T10:45:00Z", "2024-01-31T11:00:00Z"}},
+		{"@daily", []string{"2024-02-01T00:00:00Z", "2024-02-02T00:00:00Z"}},
+		{"0 0 29 2 *", []string{"2024-02-29T00:00:00Z", "2028-02-29T00:00:00Z"}},
+		{"0 0 1 * 1", []string{"2024-02-01T00:00:00Z", "2024-02-05T00:00:00Z"}},
+		{"0 0 * * 7", []string{"2024-02-04T00:00:00Z", "2024-02-11T00:00:00Z"}},
+		{"TZ=America/New_York 0 9 * * *", []string{"2024-01-31T09:00:00-05:00", "2024-02-01T09:00:00-05:00"}},
+		{"@every 90m", []string{"2024-01-31T12:00:00Z", "2024-01-31T13:30:00Z"}},
+		{"cron(0 12 L * ? *)", []string{"2024-01-31T12:00:00Z", "2024-02-29T12:00:00Z"}},
+		{"cron(0 12 ? * 2#1 *)", []string{"2024-02-05T12:00:00Z", "2024-03-04T12:00:00Z"}},
+		{"cron(0 12 ? * 6L *)", []string{"2024-02-23T12:00:00Z", "2024-03-29T12:00:00Z"}},
+		{"cron(0 12 1W * ? *)", []string{"2024-02-01T12:00:00Z", "2024-03-01T12:00:00Z", "2024-04-01T12:00:00Z", "2024-05-01T12:00:00Z", "2024-06-03T12:00:00Z"}},
+		{"cron(0 12 LW * ? *)", []string{"2024-01-31T12:00:00Z", "2024-02-29T12:00:00Z", "2024-03-29T12:00:00Z"}},
+		{"cron(0 0 1 1 ? 2025)", []string{"2025-01-01T00:00:00Z"}},
+		{"rate(2 days)", []string{"2024-02-02T10:30:00Z", "2024-02-04T10:30:00Z"}},
+	}
+
+	for _, test := range tests {
+		schedule, err := parseCron(test.expr, detectCronDialect(test.expr))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.expr, err)
+			continue
+		}
+
+		next := from
+		for i, expected := range test.expected {
+			next = schedule.next(next)
+			if actual := next.Format(time.RFC3339); actual != expected {
+				t.Errorf("%s: run %d: expected %s, got %s", test.expr, i, expected, actual)
+				break
+			}
+		}
+	}
+}
+
+func TestCronNext_Exhausted(t *testing.T) {
+	t.Parallel()
+
+	schedule, _ := parseCron("cron(0 0 1 1 ? 2020)", cronDialectAWS)
+	if next := schedule.next(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)); !next.IsZero() {
+		t.Errorf("expected no run time, got %s", next)
+	}
+
+	schedule, _ = parseCron("0 0 31 2 *", cronDialectStandard)
+	if next := schedule.next(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)); !next.IsZero() {
+		t.Errorf("expected no run time, got %s", next)
+	}
+}
+
+func TestCronNext_DaylightSavingTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expr     string
+		from     time.Time
+		expected []string
+	}{
+		{
+			// 02:30 does not exist on 2024-03-10 in New York, so that day is skipped
+			"spring forward",
+			"TZ=America/New_York 30 2 * * *",
+			time.Date(2024, time.March, 9, 12, 0, 0, 0, time.UTC),
+			[]string{"2024-03-11T02:30:00-04:00", "2024-03-12T02:30:00-04:00"},
+		},
+		{
+			"spring forward hourly",
+			"TZ=America/New_York 30 * * * *",
+			time.Date(2024, time.March, 10, 6, 0, 0, 0, time.UTC), // 01:00 EST
+			[]string{"2024-03-10T01:30:00-05:00", "2024-03-10T03:30:00-04:00"},
+		},
+		{
+			// Midnight does not exist on 2018-11-04 in Sao Paulo
+			"spring forward at midnight",
+			"TZ=America/Sao_Paulo 0 0 * * *",
+			time.Date(2018, time.November, 3, 12, 0, 0, 0, time.UTC),
+			[]string{"2018-11-05T00:00:00-02:00", "2018-11-06T00:00:00-02:00"},
+		},
+		{
+			// 01:30 occurs twice on 2024-11-03 in New York and only runs at its first occurrence
+			"fall back",
+			"TZ=America/New_York 30 1 * * *",
+			time.Date(2024, time.November, 2, 12, 0, 0, 0, time.UTC),
+			[]string{"2024-11-03T01:30:00-04:00", "2024-11-04T01:30:00-05:00"},
+		},
+		{
+			"fall back from the first occurrence",
+			"TZ=America/New_York 30 1 * * *",
+			time.Date(2024, time.November, 3, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+			[]string{"2024-11-04T01:30:00-05:00"},
+		},
+		{
+			"fall back from between the occurrences",
+			"TZ=America/New_York 30 1 * * *",
+			time.Date(2024, time.November, 3, 6, 15, 0, 0, time.UTC), // 01:15 EST
+			[]string{"2024-11-04T01:30:00-05:00"},
+		},
+		{
+			"fall back hourly",
+			"TZ=America/New_York 30 * * * *",
+			time.Date(2024, time.November, 3, 5, 0, 0, 0, time.UTC), // 01:00 EDT
+			[]string{"2024-11-03T01:30:00-04:00", "2024-11-03T02:30:00-05:00"},
+		},
+	}
+
+	for _, test := range tests {
+		schedule, err := parseCron(test.expr, cronDialectKubernetes)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		done := make(chan []string, 1)
+		go func() {
+			var actual []string
+			next := test.from
+			for range test.expected {
+				next = schedule.next(next)
+				actual = append(actual, next.Format(time.RFC3339))
+			}
+			done <- actual
+		}()
+
+		select {
+		case actual := <-done:
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: next did not return", test.name)
+		}
+	}
+}
+
+// Like Vixie cron, a day field that starts with '*' is unrestricted even when it has a step,
+// so both day fields must match
+func TestCronNext_SteppedDayFields(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, time.January, 31, 10, 30, 0, 0, time.UTC) // A Wednesday
+
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		// Odd days of the month that are Mondays
+		{"0 0 */2 * 1", "2024-02-05T00:00:00Z"},
+		// The 2nd of the month, when it is a Sunday, Tuesday, Thursday or Saturday
+		{"0 0 2 * */2", "2024-03-02T00:00:00Z"},
+		// Fields that do not start with '*' are restricted, so either day field may match
+		{"0 0 10 * 1-6/2", "2024-02-02T00:00:00Z"},
+	}
+
+	for _, test := range tests {
+		schedule, err := parseCron(test.expr, cronDialectStandard)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.expr, err)
+		}
+		if actual := schedule.next(from).Format(time.RFC3339); actual != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.expr, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var (
+	_ function.Function = CronValidateFunction{}
+)
+
+func NewCronValidateFunction() function.Function {
+	return CronValidateFunction{}
+}
+
+type CronValidateFunction struct{}
+
+func (f CronValidateFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_validate"
+}
+
+func (f CronValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns true if the cron expression is valid in the given dialect",
+		Description: "Supported dialects: standard (5-field cron), kubernetes (5-field cron with @-macros, @every, and TZ= prefixes), and aws (EventBridge cron() and rate() expressions).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The cron expression to validate",
+				Name:               "expr",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        fmt.Sprintf("The cron dialect: %s", strings.Join(cronDialects, ", ")),
+				Name:               "dialect",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f CronValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expr, dialect string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expr, &dialect))
+	if resp.Error != nil {
+		return
+
+	}
+
+	if !containsString(cronDialects, dialect) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("Unsupported dialect '%s'; must be one of: %s\n", dialect, strings.Join(cronDialects, ", "))))
+		return
+	}
+
+	_, err := parseCron(expr, dialect)
+
+	valid := err == nil
+	tflog.Debug(ctx, "Validated cron expression", map[string]any{"expression": expr, "dialect": dialect, "valid": valid})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, valid))
+}
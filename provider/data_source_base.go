@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"strings"
+)
+
+/**************************************************************
+  Base Data Source
+ **************************************************************/
+
+// panfactumDataSource is embedded by every data source to receive the provider data and to
+// share the checks that guard reads against an unconfigured or partially unknown provider
+type panfactumDataSource struct {
+	ProviderData *PanfactumProvider
+}
+
+func (d *panfactumDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if providerData := getProviderData(req.ProviderData, &resp.Diagnostics); providerData != nil {
+		d.ProviderData = providerData
+	}
+}
+
+// getProviderData returns the provider data passed to Configure, or nil if the provider
+// has not been configured yet. It is shared with any type that receives the provider data.
+func getProviderData(providerData any, diagnostics *diag.Diagnostics) *PanfactumProvider {
+	if providerData == nil {
+		return nil
+	}
+
+	data, ok := providerData.(*PanfactumProvider)
+	if !ok {
+		diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *PanfactumProvider, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil
+	}
+
+	return data
+}
+
+// unknownProviderConfigurationDescription is appended to the description of every data source
+// that uses checkProviderAttributesKnown, as stable Terraform releases do not support deferred reads
+const unknownProviderConfigurationDescription = " If the provider attributes that this data source uses are not known until apply, " +
+	"the read is deferred on Terraform versions that support deferred actions, which are still experimental, " +
+	"and fails with an error on other versions. " +
+	"To avoid the error, add the resources that those provider attributes reference to the depends_on of this data source " +
+	"so that it is read during apply instead."
+
+// checkProviderConfigured reports whether the provider data is available to the data source.
+// Terraform can read data sources before the provider is configured, such as when the provider
+// configuration depends on values that are only known after apply. Those reads are deferred when
+// Terraform supports it so that the outputs are unknown during the plan, and fail with a
+// diagnostic otherwise.
+func (d *panfactumDataSource) checkProviderConfigured(req datasource.ReadRequest, resp *datasource.ReadResponse) bool {
+	if d.ProviderData != nil {
+		return true
+	}
+
+	if req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonProviderConfigUnknown}
+		return false
+	}
+
+	resp.Diagnostics.AddError(
+		"Unconfigured provider",
+		"The pf provider has not been configured, so this data source cannot be read yet. "+
+			"This happens when the provider configuration depends on values that are only known after apply. "+
+			"Add the resources that the provider configuration references to the depends_on of this data source so that it is read during apply instead.",
+	)
+	return false
+}
+
+// checkProviderAttributesKnown reports whether the provider attributes that the outputs of a
+// data source derive from are known. Terraform does not accept unknown values from data sources,
+// so reads that depend on unknown attributes are deferred when Terraform supports it, making the
+// outputs unknown during the plan, and fail with a diagnostic otherwise rather than omitting the
+// values and producing a plan that changes on the next run.
+func (d *panfactumDataSource) checkProviderAttributesKnown(req datasource.ReadRequest, resp *datasource.ReadResponse, attributes ...string) bool {
+	var unknown []string
+	for _, attribute := range attributes {
+		if containsString(d.ProviderData.UnknownAttributes, attribute) {
+			unknown = append(unknown, attribute)
+		}
+	}
+	if len(unknown) == 0 {
+		return true
+	}
+
+	if req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonProviderConfigUnknown}
+		return false
+	}
+
+	resp.Diagnostics.AddError(
+		"Unknown provider configuration",
+		fmt.Sprintf("This data source derives its outputs from the provider attributes %s, which are not known until apply. ", strings.Join(unknown, ", "))+
+			"Add the resources that those attributes reference to the depends_on of this data source so that it is read during apply instead.",
+	)
+	return false
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"testing"
+)
+
+func TestDataSourceConfigure(t *testing.T) {
+	t.Parallel()
+
+	providerData := newTestProviderData()
+
+	for _, newDataSource := range New().DataSources(context.Background()) {
+		ds := newDataSource().(datasource.DataSourceWithConfigure)
+		metadataResp := &datasource.MetadataResponse{}
+		ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "pf"}, metadataResp)
+		name := metadataResp.TypeName
+
+		// The provider data is not available until the provider is configured
+		resp := &datasource.ConfigureResponse{}
+		ds.Configure(context.Background(), datasource.ConfigureRequest{}, resp)
+		assertDiagnostics(t, name+" nil provider data", resp.Diagnostics)
+
+		resp = &datasource.ConfigureResponse{}
+		ds.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: "invalid"}, resp)
+		assertDiagnostics(t, name+" invalid provider data", resp.Diagnostics, diag.NewErrorDiagnostic(
+			"Unexpected Provider Data Type",
+			"Expected *PanfactumProvider, got: string. Please report this issue to the provider developers.",
+		))
+
+		resp = &datasource.ConfigureResponse{}
+		ds.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: providerData}, resp)
+		assertDiagnostics(t, name+" provider data", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"math/big"
+	"testing"
+)
+
+/**************************************************************
+  Provider
+ **************************************************************/
+
+func TestProviderConfigureDiagnostics(t *testing.T) {
+	t.Setenv("KUBE_CONFIG_PATH", "testdata/kubeconfig.yaml")
+	ctx := context.Background()
+
+	p := &PanfactumProvider{}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	config := testConfig(t, schemaResp.Schema.Type(), map[string]tftypes.Value{
+		"kube_config_context": tftypes.NewValue(tftypes.String, "missing"),
+	})
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, resp)
+
+	assertDiagnostics(t, "kube_config_context", resp.Diagnostics, diag.NewAttributeErrorDiagnostic(
+		path.Root("kube_config_context"),
+		"Unable to load cluster name",
+		"no context name missing found in kubeconfig file at testdata/kubeconfig.yaml",
+	))
+}
+
+/**************************************************************
+  Data Sources
+ **************************************************************/
+
+func TestDataSourceDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	invalidExtraTags := newTestProviderData()
+	invalidExtraTags.ExtraTags = types.MapValueMust(types.Int64Type, map[string]attr.Value{"team": types.Int64Value(1)})
+
+	tests := []struct {
+		name         string
+		dataSource   datasource.DataSource
+		providerData *PanfactumProvider
+		config       map[string]tftypes.Value
+		expected     diag.Diagnostic
+	}{
+		{
+			"aws_tags extra tag",
+			NewAWSTagsDataSource(),
+			invalidExtraTags,
+			nil,
+			diag.NewAttributeErrorDiagnostic(path.Root("tags").AtMapKey("team"), "Invalid extra tag", "The value of the provider's extra_tags entry 'team' must be a string."),
+		},
+		{
+			"kube_labels extra label",
+			NewKubeLabelsDataSource(),
+			invalidExtraTags,
+			map[string]tftypes.Value{"module": tftypes.NewValue(tftypes.String, "example")},
+			diag.NewAttributeErrorDiagnostic(path.Root("labels").AtMapKey("team"), "Invalid extra label", "The value of the provider's extra_tags entry 'team' must be a string."),
+		},
+		{
+			"kube_scheduling extra label",
+			NewKubeSchedulingDataSource(),
+			invalidExtraTags,
+			map[string]tftypes.Value{"module": tftypes.NewValue(tftypes.String, "example")},
+			diag.NewAttributeErrorDiagnostic(path.Root("match_labels").AtMapKey("team"), "Invalid extra label", "The value of the provider's extra_tags entry 'team' must be a string."),
+		},
+		{
+			"kube_scheduling sla_target_override",
+			NewKubeSchedulingDataSource(),
+			newTestProviderData(),
+			map[string]tftypes.Value{
+				"module":              tftypes.NewValue(tftypes.String, "example"),
+				"sla_target_override": tftypes.NewValue(tftypes.Number, 4),
+			},
+			diag.NewAttributeErrorDiagnostic(path.Root("sla_target_override"), "Invalid SLA target", "sla_target must be between 1 and 3, got 4"),
+		},
+		{
+			"sla_profile sla_target_override",
+			NewSLAProfileDataSource(),
+			newTestProviderData(),
+			map[string]tftypes.Value{"sla_target_override": tftypes.NewValue(tftypes.Number, 0)},
+			diag.NewAttributeErrorDiagnostic(path.Root("sla_target_override"), "Invalid SLA target", "sla_target must be between 1 and 3, got 0"),
+		},
+		{
+			"kube_context kubeconfig_path",
+			NewKubeContextDataSource(),
+			newTestProviderData(),
+			map[string]tftypes.Value{
+				"context":         tftypes.NewValue(tftypes.String, "production-primary"),
+				"kubeconfig_path": tftypes.NewValue(tftypes.String, "testdata/missing.yaml"),
+			},
+			diag.NewAttributeErrorDiagnostic(path.Root("kubeconfig_path"), "Unable to load kubeconfig", "error opening YAML file: open testdata/missing.yaml: no such file or directory"),
+		},
+		{
+			"kube_context context",
+			NewKubeContextDataSource(),
+			newTestProviderData(),
+			map[string]tftypes.Value{"context": tftypes.NewValue(tftypes.String, "missing")},
+			diag.NewAttributeErrorDiagnostic(path.Root("context"), "Unable to load kubeconfig context", "no context name missing found in kubeconfig file at testdata/kubeconfig.yaml"),
+		},
+		{
+			"kube_contexts kubeconfig_path",
+			NewKubeContextsDataSource(),
+			newTestProviderData(),
+			map[string]tftypes.Value{"kubeconfig_path": tftypes.NewValue(tftypes.String, "testdata/missing.yaml")},
+			diag.NewAttributeErrorDiagnostic(path.Root("kubeconfig_path"), "Unable to load kubeconfig", "error opening YAML file: open testdata/missing.yaml: no such file or directory"),
+		},
+	}
+
+	for _, test := range tests {
+		diags := readTestDataSource(t, test.dataSource, test.providerData, test.config)
+		assertDiagnostics(t, test.name, diags, test.expected)
+	}
+}
+
+func TestDataSourceUnconfiguredProvider(t *testing.T) {
+	t.Parallel()
+
+	for _, newDataSource := range (&PanfactumProvider{}).DataSources(context.Background()) {
+		ds := newDataSource()
+		metadataResp := &datasource.MetadataResponse{}
+		ds.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "pf"}, metadataResp)
+
+		// Without deferral support, the read fails with a diagnostic rather than a panic
+		resp := readTestDataSourceResponse(t, ds, nil, datasource.ReadClientCapabilities{}, nil)
+		assertDiagnostics(t, metadataResp.TypeName, resp.Diagnostics, diag.NewErrorDiagnostic(
+			"Unconfigured provider",
+			"The pf provider has not been configured, so this data source cannot be read yet. "+
+				"This happens when the provider configuration depends on values that are only known after apply. "+
+				"Add the resources that the provider configuration references to the depends_on of this data source so that it is read during apply instead.",
+		))
+
+		// With deferral support, the read is deferred so that its outputs are unknown during the plan
+		resp = readTestDataSourceResponse(t, ds, nil, datasource.ReadClientCapabilities{DeferralAllowed: true}, nil)
+		assertDiagnostics(t, metadataResp.TypeName, resp.Diagnostics)
+		if resp.Deferred == nil || resp.Deferred.Reason != datasource.DeferredReasonProviderConfigUnknown {
+			t.Errorf("%s: expected the read to be deferred as the provider configuration is unknown, got %v", metadataResp.TypeName, resp.Deferred)
+		}
+	}
+}
+
+func TestDataSourceUnknownProviderConfiguration(t *testing.T) {
+	t.Parallel()
+
+	unknownProviderData := func(attributes ...string) *PanfactumProvider {
+		providerData := newTestProviderData()
+		providerData.UnknownAttributes = attributes
+		return providerData
+	}
+	unknownDiagnostic := func(attributes string) diag.Diagnostic {
+		return diag.NewErrorDiagnostic(
+			"Unknown provider configuration",
+			"This data source derives its outputs from the provider attributes "+attributes+", which are not known until apply. "+
+				"Add the resources that those attributes reference to the depends_on of this data source so that it is read during apply instead.",
+		)
+	}
+	module := tftypes.NewValue(tftypes.String, "example")
+
+	tests := []struct {
+		name         string
+		dataSource   datasource.DataSource
+		providerData *PanfactumProvider
+		config       map[string]tftypes.Value
+		expected     []diag.Diagnostic
+	}{
+		{
+			"aws_tags",
+			NewAWSTagsDataSource(),
+			unknownProviderData("region", "environment", "strict"),
+			nil,
+			[]diag.Diagnostic{unknownDiagnostic("environment, region")},
+		},
+		{
+			"aws_tags region_override",
+			NewAWSTagsDataSource(),
+			unknownProviderData("region"),
+			map[string]tftypes.Value{"region_override": tftypes.NewValue(tftypes.String, "us-west-2")},
+			nil,
+		},
+		{
+			"kube_labels",
+			NewKubeLabelsDataSource(),
+			unknownProviderData("extra_tags"),
+			map[string]tftypes.Value{"module": module},
+			[]diag.Diagnostic{unknownDiagnostic("extra_tags")},
+		},
+		{
+			"metadata",
+			NewMetadataDataSource(),
+			unknownProviderData("kube_cluster_name", "prevent_local_production"),
+			nil,
+			[]diag.Diagnostic{unknownDiagnostic("kube_cluster_name")},
+		},
+		{
+			"sla_profile",
+			NewSLAProfileDataSource(),
+			unknownProviderData("sla_target"),
+			nil,
+			[]diag.Diagnostic{unknownDiagnostic("sla_target")},
+		},
+		{
+			"sla_profile sla_target_override",
+			NewSLAProfileDataSource(),
+			unknownProviderData("sla_target"),
+			map[string]tftypes.Value{"sla_target_override": tftypes.NewValue(tftypes.Number, 2)},
+			nil,
+		},
+		{
+			"kube_scheduling",
+			NewKubeSchedulingDataSource(),
+			unknownProviderData("environment", "sla_target"),
+			map[string]tftypes.Value{"module": module},
+			[]diag.Diagnostic{unknownDiagnostic("environment, sla_target")},
+		},
+		{
+			"kube_scheduling overrides",
+			NewKubeSchedulingDataSource(),
+			unknownProviderData("environment", "sla_target"),
+			map[string]tftypes.Value{
+				"module":              module,
+				"match_labels":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"app": module}),
+				"sla_target_override": tftypes.NewValue(tftypes.Number, 1),
+			},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		resp := readTestDataSourceResponse(t, test.dataSource, test.providerData, datasource.ReadClientCapabilities{}, test.config)
+		assertDiagnostics(t, test.name, resp.Diagnostics, test.expected...)
+
+		// Reads that would fail are deferred instead when Terraform supports it
+		resp = readTestDataSourceResponse(t, test.dataSource, test.providerData, datasource.ReadClientCapabilities{DeferralAllowed: true}, test.config)
+		assertDiagnostics(t, test.name+" with deferral", resp.Diagnostics)
+		if deferred := resp.Deferred != nil; deferred != (len(test.expected) > 0) {
+			t.Errorf("%s: expected deferred to be %t, got %v", test.name, len(test.expected) > 0, resp.Deferred)
+		}
+	}
+}
+
+// The depends_on workaround for Terraform versions that cannot defer reads: during the plan, the
+// read fails while the provider configuration is unknown, so depends_on makes Terraform skip it.
+// During the apply, the provider is configured again with the resolved values and the read succeeds.
+func TestDataSourceDependsOnWorkaround(t *testing.T) {
+	t.Parallel()
+
+	module := map[string]tftypes.Value{"module": tftypes.NewValue(tftypes.String, "example")}
+	extraTagsType := tftypes.Map{ElementType: tftypes.String}
+	providerConfig := func(extraTags tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"environment": tftypes.NewValue(tftypes.String, "production"),
+			"region":      tftypes.NewValue(tftypes.String, "us-east-2"),
+			"extra_tags":  extraTags,
+		}
+	}
+
+	// Plan: extra_tags references an attribute of a resource that has not been created yet
+	planProviderData := configureTestProvider(t, providerConfig(tftypes.NewValue(extraTagsType, tftypes.UnknownValue)))
+
+	resp := readTestDataSourceResponse(t, NewKubeLabelsDataSource(), planProviderData, datasource.ReadClientCapabilities{}, module)
+	assertDiagnostics(t, "kube_labels during plan", resp.Diagnostics, diag.NewErrorDiagnostic(
+		"Unknown provider configuration",
+		"This data source derives its outputs from the provider attributes extra_tags, which are not known until apply. "+
+			"Add the resources that those attributes reference to the depends_on of this data source so that it is read during apply instead.",
+	))
+
+	// Data sources that do not derive their outputs from the unknown attributes are still read during the plan
+	resp = readTestDataSourceResponse(t, NewMetadataDataSource(), planProviderData, datasource.ReadClientCapabilities{}, nil)
+	assertDiagnostics(t, "metadata during plan", resp.Diagnostics)
+
+	// Apply: the resource has been created, so extra_tags is known when the data source is read
+	applyProviderData := configureTestProvider(t, providerConfig(tftypes.NewValue(extraTagsType, map[string]tftypes.Value{
+		"team": tftypes.NewValue(tftypes.String, "platform"),
+	})))
+
+	labels := readTestMap(t, NewKubeLabelsDataSource(), applyProviderData, module, "labels")
+	if labels["team"] != "platform" {
+		t.Errorf("expected the labels read during apply to include the extra tags, got %v", labels)
+	}
+}
+
+/**************************************************************
+  Functions
+ **************************************************************/
+
+func TestFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	str := types.StringValue
+	null := types.StringNull()
+
+	tests := []struct {
+		name     string
+		function function.Function
+		args     []attr.Value
+		expected *function.FuncError
+	}{
+		{"cidr_contains cidr", NewCIDRContainsFunction(), []attr.Value{str("10.0.0.0"), str("10.0.0.1")}, function.NewArgumentFuncError(0, "Invalid CIDR block: 10.0.0.0\n")},
+		{"cidr_contains ip", NewCIDRContainsFunction(), []attr.Value{str("10.0.0.0/8"), str("10.0.0")}, function.NewArgumentFuncError(1, "Invalid IP address: 10.0.0\n")},
+		{"cidrs_overlap", NewCIDRsOverlapFunction(), []attr.Value{types.ListValueMust(types.StringType, []attr.Value{str("10.0.0.0/8"), str("bad")})}, function.NewArgumentFuncError(0, "Invalid CIDR block: bad\n")},
+		{"cidr_count_hosts", NewCIDRCountHosts(), []attr.Value{str("bad")}, function.NewArgumentFuncError(0, "Invalid CIDR block: bad\n")},
+		{"cidr_count_hosts ipv6", NewCIDRCountHosts(), []attr.Value{str("2001:db8::/32")}, function.NewArgumentFuncError(0, "Invalid CIDR block: 2001:db8::/32 is not an IPv4 CIDR block\n")},
+		{"cidrs_overlap ipv6", NewCIDRsOverlapFunction(), []attr.Value{types.ListValueMust(types.StringType, []attr.Value{str("10.0.0.0/8"), str("::/0")})}, function.NewArgumentFuncError(0, "Invalid CIDR block: ::/0 is not an IPv4 CIDR block\n")},
+		{"cron_next count", NewCronNextFunction(), []attr.Value{str("0 * * * *"), str("2024-01-01T00:00:00Z"), types.Int64Value(-1)}, function.NewArgumentFuncError(2, "Count must be between 0 and 1000, got -1\n")},
+		{"cron_next from", NewCronNextFunction(), []attr.Value{str("0 * * * *"), str("yesterday"), types.Int64Value(1)}, function.NewArgumentFuncError(1, "Invalid RFC3339 timestamp: yesterday\n")},
+		{"cron_validate dialect", NewCronValidateFunction(), []attr.Value{str("0 * * * *"), str("quartz")}, function.NewArgumentFuncError(1, "Unsupported dialect 'quartz'; must be one of: standard, kubernetes, aws\n")},
+		{"duration_format style", NewDurationFormatFunction(), []attr.Value{types.NumberValue(big.NewFloat(60)), str("human")}, function.NewArgumentFuncError(1, "unsupported style 'human'; must be one of: go, iso8601, kubernetes")},
+		{"duration_format precision", NewDurationFormatFunction(), []attr.Value{types.NumberValue(big.NewFloat(1e-10)), str("go")}, function.NewArgumentFuncError(0, "duration 0.000000000100 seconds is more precise than 1ns")},
+		{"kube_quantity_add b", NewKubeQuantityAddFunction(), []attr.Value{str("1Gi"), str("1 Gi")}, function.NewArgumentFuncError(1, "quantity '1 Gi' has an unknown suffix ' Gi'")},
+		{"kube_quantity_format unit_style", NewKubeQuantityFormatFunction(), []attr.Value{types.NumberValue(big.NewFloat(1024)), str("IEC")}, function.NewArgumentFuncError(1, "unsupported format 'IEC'; must be one of: binary_si, decimal_si, decimal_exponent")},
+		{"sanitize_kube_name kind", NewSanitizeKubeNameFunction(), []attr.Value{str("example"), str("widget")}, function.NewArgumentFuncError(1, "unsupported kind 'widget'; must be one of: configmap, cronjob, daemonset, deployment, dns_1035_label, dns_1123_label, dns_1123_subdomain, ingress, job, namespace, persistentvolumeclaim, pod, secret, service, serviceaccount, statefulset")},
+		{"sanitize_kube_name name", NewSanitizeKubeNameFunction(), []attr.Value{str("___"), str("dns_1123_label")}, function.NewArgumentFuncError(0, "Name '___' does not contain any valid characters\n")},
+		{"resource_name service", NewResourceNameFunction(), []attr.Value{str("ec2"), types.ListValueMust(types.StringType, []attr.Value{str("example")})}, function.NewArgumentFuncError(0, "unsupported service 'ec2'; must be one of: elasticache, iam_policy, iam_role, lambda, lb, lb_target_group, rds, s3_bucket, security_group, sqs_queue")},
+		{"semver_satisfies constraint", NewSemverSatisfiesFunction(), []attr.Value{str("1.2.3"), str("~> one")}, function.NewArgumentFuncError(1, "'one' in constraint '~> one' is not a valid version")},
+		{"stack_version_compare b", NewStackVersionCompareFunction(), []attr.Value{str("edge"), str("latest")}, function.NewArgumentFuncError(1, "'latest' is neither a Panfactum Stack version nor a semantic version")},
+		{"stack_version_compare mixed", NewStackVersionCompareFunction(), []attr.Value{str("24-10.1"), str("1.2.3")}, function.NewFuncError("cannot compare '24-10.1' and '1.2.3' as one is a Panfactum Stack version and the other is a semantic version")},
+		{"arn_build region", NewARNBuildFunction(), []attr.Value{null, str("iam"), str("us-east-1"), str("123456789012"), str("role"), str("example")}, function.NewArgumentFuncError(2, "iam ARNs must not have a region as iam is a global service")},
+		{"arn_build partition", NewARNBuildFunction(), []attr.Value{str("aws-cn"), str("lambda"), str("us-east-1"), str("123456789012"), str("function"), str("example")}, function.NewArgumentFuncError(0, "region 'us-east-1' is in the 'aws' partition, not 'aws-cn'")},
+		{"arn_build account_id", NewARNBuildFunction(), []attr.Value{null, str("lambda"), str("us-east-1"), null, str("function"), str("example")}, function.NewArgumentFuncError(3, "lambda ARNs require an account id")},
+		{"short_hash length", NewShortHashFunction(), []attr.Value{str("input"), types.Int64Value(0), str("hex")}, function.NewArgumentFuncError(1, "length must be between 1 and 64 for the hex alphabet")},
+		{"short_hash alphabet", NewShortHashFunction(), []attr.Value{str("input"), types.Int64Value(8), str("base64")}, function.NewArgumentFuncError(2, "'base64' is not a supported alphabet (base32, base36, hex)")},
+	}
+
+	for _, test := range tests {
+		if _, err := runTestFunction(t, test.function, test.args...); !err.Equal(test.expected) {
+			t.Errorf("%s: expected error %#v, got %#v", test.name, test.expected, err)
+		}
+	}
+}
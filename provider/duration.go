@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// Durations are represented as exact rational numbers of seconds so that
+// fractional inputs like 1.5h or PT0.5S convert without floating point error.
+
+const (
+	durationStyleGo         = "go"
+	durationStyleISO8601    = "iso8601"
+	durationStyleKubernetes = "kubernetes"
+)
+
+var durationStyles = []string{durationStyleGo, durationStyleISO8601, durationStyleKubernetes}
+
+var durationUnitSeconds = map[string]*big.Rat{
+	"ns": big.NewRat(1, 1000000000),
+	"us": big.NewRat(1, 1000000),
+	"µs": big.NewRat(1, 1000000),
+	"μs": big.NewRat(1, 1000000),
+	"ms": big.NewRat(1, 1000),
+	"s":  big.NewRat(1, 1),
+	"m":  big.NewRat(60, 1),
+	"h":  big.NewRat(3600, 1),
+	"d":  big.NewRat(86400, 1),
+	"w":  big.NewRat(604800, 1),
+}
+
+var (
+	durationGoComponentRegex = regexp.MustCompile(`^([0-9]*\.?[0-9]*)(ns|us|µs|μs|ms|s|m|h|d|w)`)
+	durationISO8601Regex     = regexp.MustCompile(`^P(?:([0-9]+(?:[.,][0-9]+)?)W)?(?:([0-9]+(?:[.,][0-9]+)?)D)?(?:T(?:([0-9]+(?:[.,][0-9]+)?)H)?(?:([0-9]+(?:[.,][0-9]+)?)M)?(?:([0-9]+(?:[.,][0-9]+)?)S)?)?$`)
+)
+
+// parseDuration parses a Go duration with optional day (d) and week (w) units (e.g., 1d12h),
+// or an ISO-8601 duration (e.g., PT1H30M or P1D), into seconds. Years and months are
+// rejected in ISO-8601 durations as they do not have a fixed length.
+func parseDuration(input string) (*big.Rat, error) {
+	if input == "" {
+		return nil, fmt.Errorf("duration must not be empty")
+	}
+
+	negative := false
+	rest := input
+	switch rest[0] {
+	case '-':
+		negative = true
+		rest = rest[1:]
+	case '+':
+		rest = rest[1:]
+	}
+
+	var seconds *big.Rat
+	var err error
+	if strings.HasPrefix(rest, "P") {
+		seconds, err = parseISO8601Duration(rest)
+	} else {
+		seconds, err = parseGoDuration(rest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration '%s': %v", input, err)
+	}
+
+	if negative {
+		seconds.Neg(seconds)
+	}
+	return seconds, nil
+}
+
+func parseGoDuration(input string) (*big.Rat, error) {
+	// Like time.ParseDuration, a bare zero is allowed without a unit
+	if input == "0" {
+		return new(big.Rat), nil
+	}
+	if input == "" {
+		return nil, fmt.Errorf("missing value")
+	}
+
+	total := new(big.Rat)
+	for input != "" {
+		match := durationGoComponentRegex.FindStringSubmatch(input)
+		if match == nil {
+			return nil, fmt.Errorf("expected a number followed by one of the units ns, us, ms, s, m, h, d, w")
+		}
+		value, ok := new(big.Rat).SetString(match[1])
+		if !ok || match[1] == "." {
+			return nil, fmt.Errorf("invalid number '%s'", match[1])
+		}
+		total.Add(total, value.Mul(value, durationUnitSeconds[match[2]]))
+		input = input[len(match[0]):]
+	}
+	return total, nil
+}
+
+func parseISO8601Duration(input string) (*big.Rat, error) {
+	match := durationISO8601Regex.FindStringSubmatch(input)
+	if match == nil || input == "P" || strings.HasSuffix(input, "T") {
+		return nil, fmt.Errorf("expected an ISO-8601 duration of the form PnWnDTnHnMnS (years and months are not supported)")
+	}
+
+	total := new(big.Rat)
+	for i, unit := range []string{"w", "d", "h", "m", "s"} {
+		if match[i+1] == "" {
+			continue
+		}
+		value, ok := new(big.Rat).SetString(strings.Replace(match[i+1], ",", ".", 1))
+		if !ok {
+			return nil, fmt.Errorf("invalid number '%s'", match[i+1])
+		}
+		total.Add(total, value.Mul(value, durationUnitSeconds[unit]))
+	}
+	return total, nil
+}
+
+// formatDuration renders the number of seconds in the given style:
+//
+//	go:         Go duration syntax using h, m, s, ms, us, and ns (e.g., 1h30m0.5s)
+//	iso8601:    ISO-8601 duration using days and time components (e.g., P1DT1H30M)
+//	kubernetes: Go duration syntax using only h, m, and s, which every Kubernetes duration field accepts (e.g., 36h30m)
+//
+// Fractional seconds beyond nanosecond precision are rejected.
+func formatDuration(seconds *big.Rat, style string) (string, error) {
+	nanos := new(big.Rat).Mul(seconds, big.NewRat(1000000000, 1))
+	if !nanos.IsInt() {
+		return "", newArgumentError(0, "duration %s seconds is more precise than 1ns", seconds.FloatString(12))
+	}
+
+	value := new(big.Int).Set(nanos.Num())
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+		value.Neg(value)
+	}
+
+	switch style {
+	case durationStyleGo:
+		return sign + formatDurationUnits(value, []string{"h", "m", "s"}, true), nil
+	case durationStyleKubernetes:
+		return sign + formatDurationUnits(value, []string{"h", "m", "s"}, false), nil
+	case durationStyleISO8601:
+		return sign + formatISO8601Duration(value), nil
+	default:
+		return "", newArgumentError(1, "unsupported style '%s'; must be one of: %s", style, strings.Join(durationStyles, ", "))
+	}
+}
+
+var nanosPerUnit = map[string]*big.Int{
+	"d": big.NewInt(86400000000000),
+	"h": big.NewInt(3600000000000),
+	"m": big.NewInt(60000000000),
+	"s": big.NewInt(1000000000),
+}
+
+// formatDurationUnits renders the nanoseconds using the given units, omitting zero-valued units.
+// Fractional seconds are rendered with the smallest exact sub-second unit if subSecondUnits is
+// true, and as a decimal number of seconds otherwise.
+func formatDurationUnits(nanos *big.Int, units []string, subSecondUnits bool) string {
+	if nanos.Sign() == 0 {
+		return "0s"
+	}
+
+	var builder strings.Builder
+	remaining := new(big.Int).Set(nanos)
+	for _, unit := range units[:len(units)-1] {
+		quotient, remainder := new(big.Int).QuoRem(remaining, nanosPerUnit[unit], new(big.Int))
+		if quotient.Sign() != 0 {
+			builder.WriteString(quotient.String() + unit)
+		}
+		remaining = remainder
+	}
+
+	if remaining.Sign() == 0 {
+		return builder.String()
+	}
+
+	if subSecondUnits && remaining.Cmp(nanosPerUnit["s"]) < 0 {
+		for _, unit := range []struct {
+			name  string
+			nanos int64
+		}{{"ms", 1000000}, {"us", 1000}, {"ns", 1}} {
+			if new(big.Int).Rem(remaining, big.NewInt(unit.nanos)).Sign() == 0 {
+				builder.WriteString(new(big.Int).Quo(remaining, big.NewInt(unit.nanos)).String() + unit.name)
+				return builder.String()
+			}
+		}
+	}
+
+	builder.WriteString(formatDurationSeconds(remaining) + "s")
+	return builder.String()
+}
+
+func formatISO8601Duration(nanos *big.Int) string {
+	if nanos.Sign() == 0 {
+		return "PT0S"
+	}
+
+	days, remaining := new(big.Int).QuoRem(nanos, nanosPerUnit["d"], new(big.Int))
+
+	var builder strings.Builder
+	builder.WriteString("P")
+	if days.Sign() != 0 {
+		builder.WriteString(days.String() + "D")
+	}
+	if remaining.Sign() != 0 {
+		builder.WriteString("T")
+		for _, unit := range []string{"h", "m"} {
+			quotient, remainder := new(big.Int).QuoRem(remaining, nanosPerUnit[unit], new(big.Int))
+			if quotient.Sign() != 0 {
+				builder.WriteString(quotient.String() + strings.ToUpper(unit))
+			}
+			remaining = remainder
+		}
+		if remaining.Sign() != 0 {
+			builder.WriteString(formatDurationSeconds(remaining) + "S")
+		}
+	}
+	return builder.String()
+}
+
+// formatDurationSeconds renders nanoseconds as a decimal number of seconds without trailing zeros
+func formatDurationSeconds(nanos *big.Int) string {
+	formatted := new(big.Rat).SetFrac(nanos, nanosPerUnit["s"]).FloatString(9)
+	formatted = strings.TrimRight(formatted, "0")
+	return strings.TrimSuffix(formatted, ".")
+}
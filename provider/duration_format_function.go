@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"math/big"
+	"strings"
+)
+
+var (
+	_ function.Function = DurationFormatFunction{}
+)
+
+func NewDurationFormatFunction() function.Function {
+	return DurationFormatFunction{}
+}
+
+type DurationFormatFunction struct{}
+
+func (f DurationFormatFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_format"
+}
+
+func (f DurationFormatFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a number of seconds formatted as a duration",
+		Description: "Supported styles: go (e.g., 1h30m or 500ms), iso8601 (e.g., PT1H30M or P1DT12H), and kubernetes (e.g., 36h or 0.5s), which only uses the h, m, and s units.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The number of seconds to format",
+				Name:               "seconds",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        fmt.Sprintf("The style of the duration: %s", strings.Join(durationStyles, ", ")),
+				Name:               "style",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f DurationFormatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seconds *big.Float
+	var style string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &seconds, &style))
+	if resp.Error != nil {
+		return
+
+	}
+
+	secondsRat, ok := bigFloatToRat(seconds)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "Seconds must be a finite number\n"))
+		return
+	}
+
+	formatted, err := formatDuration(secondsRat, style)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newFuncError(err, 1))
+		return
+	}
+
+	tflog.Debug(ctx, "Formatted duration", map[string]any{"seconds": seconds.String(), "style": style, "duration": formatted})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, formatted))
+}
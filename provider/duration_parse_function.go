@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"math/big"
+)
+
+var (
+	_ function.Function = DurationParseFunction{}
+)
+
+func NewDurationParseFunction() function.Function {
+	return DurationParseFunction{}
+}
+
+type DurationParseFunction struct{}
+
+func (f DurationParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_parse"
+}
+
+func (f DurationParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the number of seconds in a duration",
+		Description: "Accepts Go durations (e.g., 1h30m or 500ms) with additional d (day) and w (week) units, and ISO-8601 durations (e.g., PT1H30M or P1DT12H). ISO-8601 years and months are not supported as they do not have a fixed length.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The duration to parse",
+				Name:               "duration",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f DurationParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+
+	}
+
+	seconds, err := parseDuration(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := new(big.Float).SetPrec(512).SetRat(seconds)
+	tflog.Debug(ctx, "Parsed duration", map[string]any{"duration": input, "seconds": seconds.RatString()})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"30s", "30"},
+		{"5m", "300"},
+		{"1h30m", "5400"},
+		{"1.5h", "5400"},
+		{"500ms", "1/2"},
+		{"1d", "86400"},
+		{"2w1d", "1296000"},
+		{"0", "0"},
+		{"-5m", "-300"},
+		{"1µs", "1/1000000"},
+		{"PT1H", "3600"},
+		{"PT1H30M", "5400"},
+		{"P1D", "86400"},
+		{"P1DT12H", "129600"},
+		{"P2W", "1209600"},
+		{"PT0.5S", "1/2"},
+		{"PT0,5S", "1/2"},
+		{"-PT1M", "-60"},
+	}
+
+	for _, test := range tests {
+		seconds, err := parseDuration(test.input)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.input, err)
+			continue
+		}
+		if seconds.RatString() != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.input, test.expected, seconds.RatString())
+		}
+	}
+}
+
+func TestParseDuration_Malformed(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "5", "5x", "1h 30m", "h", ".s", "P", "PT", "P1Y", "P1M", "PT1H1D", "1hP1D", "--5m"} {
+		if _, err := parseDuration(input); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		seconds  string
+		style    string
+		expected string
+	}{
+		{"5400", durationStyleGo, "1h30m"},
+		{"129600", durationStyleGo, "36h"},
+		{"1/2", durationStyleGo, "500ms"},
+		{"3/2", durationStyleGo, "1.5s"},
+		{"0", durationStyleGo, "0s"},
+		{"-90", durationStyleGo, "-1m30s"},
+		{"1/1000000000", durationStyleGo, "1ns"},
+		{"129600", durationStyleISO8601, "P1DT12H"},
+		{"86400", durationStyleISO8601, "P1D"},
+		{"5400", durationStyleISO8601, "PT1H30M"},
+		{"1/2", durationStyleISO8601, "PT0.5S"},
+		{"0", durationStyleISO8601, "PT0S"},
+		{"129600", durationStyleKubernetes, "36h"},
+		{"1/2", durationStyleKubernetes, "0.5s"},
+		{"3661", durationStyleKubernetes, "1h1m1s"},
+	}
+
+	for _, test := range tests {
+		seconds, _ := new(big.Rat).SetString(test.seconds)
+		actual, err := formatDuration(seconds, test.style)
+		if err != nil {
+			t.Errorf("%s %s: unexpected error: %v", test.seconds, test.style, err)
+		} else if actual != test.expected {
+			t.Errorf("%s %s: expected %s, got %s", test.seconds, test.style, test.expected, actual)
+		}
+	}
+
+	if _, err := formatDuration(big.NewRat(1, 3), durationStyleGo); err == nil {
+		t.Error("expected an error for a duration more precise than 1ns")
+	}
+	if _, err := formatDuration(big.NewRat(1, 1), "rfc"); err == nil {
+		t.Error("expected an error for an unsupported style")
+	}
+}
+
+func TestDuration_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, style := range durationStyles {
+		for _, input := range []string{"1h30m", "36h", "500ms", "1.5s", "1d1ns"} {
+			seconds, _ := parseDuration(input)
+			formatted, err := formatDuration(seconds, style)
+			if err != nil {
+				t.Fatalf("%s %s: unexpected error: %v", input, style, err)
+			}
+			reparsed, err := parseDuration(formatted)
+			if err != nil || reparsed.Cmp(seconds) != 0 {
+				t.Errorf("%s %s: %s did not round trip", input, style, formatted)
+			}
+		}
+	}
+}
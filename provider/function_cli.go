@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"io"
+	"math/big"
+	"strings"
+)
+
+/**************************************************************
+  Function CLI
+ **************************************************************/
+
+const functionCLIUsage = `Usage: terraform-provider-pf fn <function> [arguments...]
+
+Evaluates a provider function outside of Terraform and prints the result as JSON.
+
+Arguments are matched to the function's parameters in order. String parameters take
+the argument as-is, and all other parameters parse the argument as JSON. Prefix an
+argument with --json to always parse it as JSON (e.g., --json null).
+
+Run 'terraform-provider-pf fn <function> --help' to show the parameters of a function.
+`
+
+// RunFunctionCLI invokes one of the functions registered by the provider with the given
+// command line arguments and writes the JSON-encoded result to stdout. It returns the
+// process exit code.
+func RunFunctionCLI(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) int {
+	functions := functionCLIDefinitions(ctx)
+
+	if len(args) == 0 || args[0] == "--help" || args[0] == "-h" {
+		fmt.Fprint(stdout, functionCLIUsage)
+		fmt.Fprintln(stdout, "\nFunctions:")
+		for _, name := range sortedKeys(functions) {
+			fmt.Fprintf(stdout, "  %-26s %s\n", name, functions[name].definition.Summary)
+		}
+		return 0
+	}
+
+	fn, ok := functions[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "Error: '%s' is not a provider function. Run 'terraform-provider-pf fn' to list the available functions.\n", args[0])
+		return 1
+	}
+
+	if containsString(args[1:], "--help") || containsString(args[1:], "-h") {
+		printFunctionCLIHelp(stdout, args[0], fn.definition)
+		return 0
+	}
+
+	arguments, err := parseFunctionCLIArguments(ctx, fn.definition, args[1:])
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n\n", err)
+		printFunctionCLIHelp(stderr, args[0], fn.definition)
+		return 1
+	}
+
+	result, funcErr := fn.definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", funcErr.Text)
+		return 1
+	}
+
+	resp := &function.RunResponse{Result: result}
+	fn.function.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	if resp.Error != nil {
+		if resp.Error.FunctionArgument != nil {
+			fmt.Fprintf(stderr, "Error: invalid value for %s: %s\n", functionCLIParameterName(fn.definition, *resp.Error.FunctionArgument), strings.TrimSpace(resp.Error.Text))
+		} else {
+			fmt.Fprintf(stderr, "Error: %s\n", strings.TrimSpace(resp.Error.Text))
+		}
+		return 1
+	}
+
+	output, err := functionCLIResultJSON(ctx, resp.Result.Value())
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: unable to encode the result as JSON: %v\n", err)
+		return 1
+	}
+
+	fmt.Fprintln(stdout, output)
+	return 0
+}
+
+// functionCLIParameterName returns the name of the parameter that receives the argument at
+// the given position, including the arguments of a variadic parameter
+func functionCLIParameterName(definition function.Definition, argument int64) string {
+	if argument < int64(len(definition.Parameters)) {
+		return definition.Parameters[argument].GetName()
+	}
+	if definition.VariadicParameter != nil {
+		return definition.VariadicParameter.GetName()
+	}
+	return fmt.Sprintf("argument %d", argument)
+}
+
+type functionCLIDefinition struct {
+	function   function.Function
+	definition function.Definition
+}
+
+// functionCLIDefinitions returns the functions registered by the provider keyed by name
+func functionCLIDefinitions(ctx context.Context) map[string]functionCLIDefinition {
+	p := &PanfactumProvider{}
+	functions := map[string]functionCLIDefinition{}
+
+	for _, newFunction := range p.Functions(ctx) {
+		fn := newFunction()
+
+		metadata := &function.MetadataResponse{}
+		fn.Metadata(ctx, function.MetadataRequest{}, metadata)
+
+		definition := &function.DefinitionResponse{}
+		fn.Definition(ctx, function.DefinitionRequest{}, definition)
+
+		functions[metadata.Name] = functionCLIDefinition{
+			function:   fn,
+			definition: definition.Definition,
+		}
+	}
+
+	return functions
+}
+
+func printFunctionCLIHelp(w io.Writer, name string, definition function.Definition) {
+	var signature []string
+	for _, param := range definition.Parameters {
+		signature = append(signature, "<"+param.GetName()+">")
+	}
+	if definition.VariadicParameter != nil {
+		signature = append(signature, "["+definition.VariadicParameter.GetName()+"...]")
+	}
+
+	fmt.Fprintf(w, "Usage: terraform-provider-pf fn %s %s\n\n%s\n", name, strings.Join(signature, " "), definition.Summary)
+	if definition.Description != "" {
+		fmt.Fprintf(w, "\n%s\n", definition.Description)
+	}
+
+	params := definition.Parameters
+	if definition.VariadicParameter != nil {
+		params = append(append([]function.Parameter{}, params...), definition.VariadicParameter)
+	}
+	if len(params) > 0 {
+		fmt.Fprintln(w, "\nParameters:")
+		for _, param := range params {
+			fmt.Fprintf(w, "  %-16s %-16s %s\n", param.GetName(), functionCLITypeName(param.GetType().TerraformType(context.Background())), param.GetDescription())
+		}
+	}
+}
+
+// functionCLITypeName returns the type constraint syntax used by Terraform for the type
+func functionCLITypeName(typ tftypes.Type) string {
+	switch typed := typ.(type) {
+	case tftypes.List:
+		return "list(" + functionCLITypeName(typed.ElementType) + ")"
+	case tftypes.Set:
+		return "set(" + functionCLITypeName(typed.ElementType) + ")"
+	case tftypes.Map:
+		return "map(" + functionCLITypeName(typed.ElementType) + ")"
+	case tftypes.Object:
+		return "object"
+	case tftypes.Tuple:
+		return "tuple"
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		return "string"
+	case typ.Is(tftypes.Number):
+		return "number"
+	case typ.Is(tftypes.Bool):
+		return "bool"
+	default:
+		return "any"
+	}
+}
+
+/**************************************************************
+  Arguments
+ **************************************************************/
+
+// parseFunctionCLIArguments converts the command line arguments into values for the
+// parameters of the function. Variadic arguments are collected into a tuple as the
+// framework expects.
+func parseFunctionCLIArguments(ctx context.Context, definition function.Definition, args []string) ([]attr.Value, error) {
+	var values []attr.Value
+	var variadicValues []attr.Value
+	var variadicTypes []attr.Type
+
+	for index := 0; len(args) > 0; index++ {
+		forceJSON := false
+		if args[0] == "--json" {
+			if len(args) == 1 {
+				return nil, fmt.Errorf("--json must be followed by an argument")
+			}
+			forceJSON = true
+			args = args[1:]
+		} else if args[0] == "--" && len(args) > 1 {
+			args = args[1:]
+		}
+		arg := args[0]
+		args = args[1:]
+
+		// A trailing list parameter may be given as separate arguments rather than as a JSON array
+		// (e.g., fn cidrs_overlap 10.0.0.0/16 10.0.0.0/8)
+		if index == len(definition.Parameters)-1 && definition.VariadicParameter == nil && !forceJSON && !strings.HasPrefix(strings.TrimSpace(arg), "[") {
+			if listArg, ok := functionCLIListArgument(ctx, definition.Parameters[index], append([]string{arg}, args...)); ok {
+				arg, args = listArg, nil
+			}
+		}
+
+		var param function.Parameter
+		switch {
+		case index < len(definition.Parameters):
+			param = definition.Parameters[index]
+		case definition.VariadicParameter != nil:
+			param = definition.VariadicParameter
+		default:
+			return nil, fmt.Errorf("too many arguments: expected %d", len(definition.Parameters))
+		}
+
+		value, err := parseFunctionCLIArgument(ctx, param, arg, forceJSON)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", param.GetName(), err)
+		}
+
+		if index < len(definition.Parameters) {
+			values = append(values, value)
+		} else {
+			variadicValues = append(variadicValues, value)
+			variadicTypes = append(variadicTypes, value.Type(ctx))
+		}
+	}
+
+	if len(values) < len(definition.Parameters) {
+		var missing []string
+		for _, param := range definition.Parameters[len(values):] {
+			missing = append(missing, param.GetName())
+		}
+		return nil, fmt.Errorf("missing arguments: %s", strings.Join(missing, ", "))
+	}
+
+	if definition.VariadicParameter != nil {
+		tuple, diags := types.TupleValue(variadicTypes, variadicValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("invalid variadic arguments: %v", diags)
+		}
+		values = append(values, tuple)
+	}
+
+	return values, nil
+}
+
+// functionCLIListArgument encodes the arguments as a JSON array if the parameter is a list or
+// set. String elements are taken as-is, and all other elements are parsed as JSON.
+func functionCLIListArgument(ctx context.Context, param function.Parameter, args []string) (string, bool) {
+	var elementType tftypes.Type
+	switch typ := param.GetType().TerraformType(ctx).(type) {
+	case tftypes.List:
+		elementType = typ.ElementType
+	case tftypes.Set:
+		elementType = typ.ElementType
+	default:
+		return "", false
+	}
+
+	elements := make([]string, 0, len(args))
+	for _, arg := range args {
+		if elementType.Is(tftypes.String) {
+			encoded, err := json.Marshal(arg)
+			if err != nil {
+				return "", false
+			}
+			elements = append(elements, string(encoded))
+		} else {
+			elements = append(elements, arg)
+		}
+	}
+	return "[" + strings.Join(elements, ",") + "]", true
+}
+
+func parseFunctionCLIArgument(ctx context.Context, param function.Parameter, arg string, forceJSON bool) (attr.Value, error) {
+	paramType := param.GetType()
+	tfType := paramType.TerraformType(ctx)
+
+	var tfValue tftypes.Value
+	switch {
+	case tfType.Is(tftypes.String) && !forceJSON:
+		tfValue = tftypes.NewValue(tftypes.String, arg)
+	case tfType.Is(tftypes.DynamicPseudoType):
+		// Dynamic parameters take the type of the value, and bare words are strings
+		decoded, err := decodeFunctionCLIJSON(arg)
+		if err != nil {
+			if forceJSON {
+				return nil, err
+			}
+			decoded = arg
+		}
+		tfValue, err = goToTFTypes(decoded)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		if !json.Valid([]byte(arg)) {
+			return nil, fmt.Errorf("expected JSON of type %s", functionCLITypeName(tfType))
+		}
+		var err error
+		tfValue, err = tftypes.ValueFromJSONWithOpts([]byte(arg), tfType, tftypes.ValueFromJSONOpts{})
+		if err != nil {
+			return nil, fmt.Errorf("expected JSON of type %s: %w", functionCLITypeName(tfType), err)
+		}
+	}
+
+	// Terraform rejects null arguments before calling the provider unless the parameter allows them
+	if tfValue.IsNull() && !param.GetAllowNullValue() {
+		return nil, fmt.Errorf("null is not allowed")
+	}
+
+	return paramType.ValueFromTerraform(ctx, tfValue)
+}
+
+func decodeFunctionCLIJSON(input string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(input))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON value")
+	}
+	return decoded, nil
+}
+
+// goToTFTypes infers the Terraform type of a decoded JSON value. Arrays become tuples and
+// objects become objects so that elements may have different types.
+func goToTFTypes(value any) (tftypes.Value, error) {
+	switch typed := value.(type) {
+	case nil:
+		return tftypes.NewValue(tftypes.DynamicPseudoType, nil), nil
+	case string:
+		return tftypes.NewValue(tftypes.String, typed), nil
+	case bool:
+		return tftypes.NewValue(tftypes.Bool, typed), nil
+	case json.Number:
+		number, ok := new(big.Float).SetString(typed.String())
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("'%s' is not a valid number", typed)
+		}
+		return tftypes.NewValue(tftypes.Number, number), nil
+	case []any:
+		elementTypes := make([]tftypes.Type, 0, len(typed))
+		elements := make([]tftypes.Value, 0, len(typed))
+		for _, item := range typed {
+			element, err := goToTFTypes(item)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elementTypes = append(elementTypes, element.Type())
+			elements = append(elements, element)
+		}
+		return tftypes.NewValue(tftypes.Tuple{ElementTypes: elementTypes}, elements), nil
+	case map[string]any:
+		attributeTypes := make(map[string]tftypes.Type, len(typed))
+		attributes := make(map[string]tftypes.Value, len(typed))
+		for key, item := range typed {
+			attribute, err := goToTFTypes(item)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			attributeTypes[key] = attribute.Type()
+			attributes[key] = attribute
+		}
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, attributes), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported JSON value %v", value)
+	}
+}
+
+/**************************************************************
+  Results
+ **************************************************************/
+
+func functionCLIResultJSON(ctx context.Context, value attr.Value) (string, error) {
+	if dynamic, ok := value.(types.Dynamic); ok {
+		value = dynamic.UnderlyingValue()
+	}
+	if value == nil {
+		return "null", nil
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	decoded, err := tftypesToGo(tfValue)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonNumbers(decoded)); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// jsonNumbers replaces the *big.Float values produced by tftypesToGo with JSON numbers
+func jsonNumbers(value any) any {
+	switch typed := value.(type) {
+	case *big.Float:
+		if typed.IsInt() {
+			return json.Number(typed.Text('f', 0))
+		}
+		return json.Number(typed.Text('g', -1))
+	case map[string]any:
+		for key, item := range typed {
+			typed[key] = jsonNumbers(item)
+		}
+		return typed
+	case []any:
+		for i, item := range typed {
+			typed[i] = jsonNumbers(item)
+		}
+		return typed
+	default:
+		return value
+	}
+}
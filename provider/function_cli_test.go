@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRunFunctionCLI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"cidrs_overlap", "10.0.0.0/16", "10.0.0.0/8"}, "true"},
+		{[]string{"cidrs_overlap", `["10.0.0.0/16", "11.0.0.0/8"]`}, "false"},
+		{[]string{"cidr_contains", "10.0.0.0/8", "10.1.2.3"}, "true"},
+		{[]string{"sanitize_kube_labels", `{"app name": "web server"}`}, "{\n  \"app.name\": \"web.server\"\n}"},
+		{[]string{"resource_name", "iam_role", "Production", "api"}, `"Production-api"`},
+		{[]string{"duration_parse", "1.5h"}, "5400"},
+		{[]string{"duration_parse", "250ms"}, "0.25"},
+		{[]string{"kube_quantity_compare", "1Gi", "1G"}, "1"},
+		{[]string{"kube_selector_matches", "app=web", `{"app": "web"}`}, "true"},
+		{[]string{"kube_selector_matches", "--json", `{"match_labels": {"app": "web"}}`, `{"app": "db"}`}, "false"},
+		{[]string{"arn_build", "--json", "null", "iam", "--json", "null", "123456789012", "role", "example"}, `"arn:aws:iam::123456789012:role/example"`},
+		{[]string{"stable_id"}, `"` + stableID(nil) + `"`},
+		{[]string{"stable_id", "a", "b"}, `"` + stableID([]string{"a", "b"}) + `"`},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := RunFunctionCLI(context.Background(), test.args, &stdout, &stderr)
+		if code != 0 {
+			t.Errorf("RunFunctionCLI(%q) exited with %d: %s", test.args, code, stderr.String())
+			continue
+		}
+		if result := strings.TrimSpace(stdout.String()); result != test.expected {
+			t.Errorf("RunFunctionCLI(%q) = %s, expected %s", test.args, result, test.expected)
+		}
+	}
+}
+
+func TestRunFunctionCLIErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"not_a_function"}, "is not a provider function"},
+		{[]string{"cidr_contains", "10.0.0.0/8"}, "missing arguments: ipv4_address"},
+		{[]string{"cidr_contains", "10.0.0.0/8", "10.1.2.3", "extra"}, "too many arguments"},
+		{[]string{"cidr_contains", "not-a-cidr", "10.1.2.3"}, "Error: invalid value for ipv4_cidr_block: Invalid CIDR block: not-a-cidr"},
+		{[]string{"stable_id", "a", "b", "--json", "null"}, "invalid value for parts"},
+		{[]string{"kube_quantity_compare", "--json", "1Gi", "1G"}, "invalid value for a"},
+		{[]string{"short_hash", "input", "eight", "hex"}, "invalid value for length"},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		code := RunFunctionCLI(context.Background(), test.args, &stdout, &stderr)
+		if code == 0 {
+			t.Errorf("RunFunctionCLI(%q) expected a non-zero exit code, got output %s", test.args, stdout.String())
+			continue
+		}
+		if !strings.Contains(stderr.String(), test.expected) {
+			t.Errorf("RunFunctionCLI(%q) error = %q, expected it to contain %q", test.args, stderr.String(), test.expected)
+		}
+	}
+}
+
+func TestRunFunctionCLIHelp(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+	if code := RunFunctionCLI(context.Background(), nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("RunFunctionCLI() exited with %d", code)
+	}
+	for name := range functionCLIDefinitions(context.Background()) {
+		if !strings.Contains(stdout.String(), name) {
+			t.Errorf("RunFunctionCLI() does not list %s", name)
+		}
+	}
+
+	stdout.Reset()
+	if code := RunFunctionCLI(context.Background(), []string{"short_hash", "--help"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("RunFunctionCLI(short_hash --help) exited with %d", code)
+	}
+	if !strings.Contains(stdout.String(), "Usage: terraform-provider-pf fn short_hash <input> <length> <alphabet>") {
+		t.Errorf("RunFunctionCLI(short_hash --help) = %s", stdout.String())
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// argumentError is returned by helpers that check several function arguments at once
+// so that the function can report which of its arguments is at fault
+type argumentError struct {
+	argument int64
+	err      error
+}
+
+func (e *argumentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *argumentError) Unwrap() error {
+	return e.err
+}
+
+func newArgumentError(argument int64, format string, a ...any) error {
+	return &argumentError{argument: argument, err: fmt.Errorf(format, a...)}
+}
+
+// newFuncError converts the error into a function error that points at the argument
+// recorded by an argumentError, or at the given argument otherwise. Pass a negative
+// argument for errors that do not belong to any one argument.
+func newFuncError(err error, argument int64) *function.FuncError {
+	var argErr *argumentError
+	if errors.As(err, &argErr) {
+		argument = argErr.argument
+	}
+	if argument < 0 {
+		return function.NewFuncError(err.Error())
+	}
+	return function.NewArgumentFuncError(argument, err.Error())
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"testing"
+)
+
+/**************************************************************
+  Harness
+ **************************************************************/
+
+// runTestFunction calls the function with the given arguments and returns its result and error
+func runTestFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	definitionResp := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definitionResp)
+	result, err := definitionResp.Definition.Return.NewResultData(ctx)
+	if err != nil {
+		t.Fatalf("unable to create result data: %v", err)
+	}
+
+	resp := &function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// testConfig builds a configuration for the schema where every attribute not in values is null
+func testConfig(t *testing.T, schemaType attr.Type, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := schemaType.TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected an object schema, got %T", schemaType)
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("%s is not an attribute of the schema", name)
+		}
+		attributes[name] = value
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// configureTestProvider configures the provider with the given configuration and returns the
+// provider data that it passes to the data sources
+func configureTestProvider(t *testing.T, values map[string]tftypes.Value) *PanfactumProvider {
+	t.Helper()
+	ctx := context.Background()
+
+	p := &PanfactumProvider{}
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testConfig(t, schemaResp.Schema.Type(), values)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.DataSourceData.(*PanfactumProvider)
+}
+
+// readTestDataSource configures the data source with the provider data and reads it with the given configuration
+func readTestDataSource(t *testing.T, ds datasource.DataSource, providerData *PanfactumProvider, values map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+	return readTestDataSourceResponse(t, ds, providerData, datasource.ReadClientCapabilities{}, values).Diagnostics
+}
+
+// readTestDataSourceResponse is readTestDataSource with the client capabilities of the request.
+// A nil providerData is passed to Configure as-is, as it is before the provider is configured.
+func readTestDataSourceResponse(t *testing.T, ds datasource.DataSource, providerData *PanfactumProvider, capabilities datasource.ReadClientCapabilities, values map[string]tftypes.Value) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type()
+
+	configureReq := datasource.ConfigureRequest{}
+	if providerData != nil {
+		configureReq.ProviderData = providerData
+	}
+	configureResp := &datasource.ConfigureResponse{}
+	ds.(datasource.DataSourceWithConfigure).Configure(ctx, configureReq, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return &datasource.ReadResponse{Diagnostics: configureResp.Diagnostics}
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType.TerraformType(ctx), nil)}}
+	ds.Read(ctx, datasource.ReadRequest{
+		Config:             tfsdk.Config{Schema: schemaResp.Schema, Raw: testConfig(t, schemaType, values)},
+		ClientCapabilities: capabilities,
+	}, resp)
+	return resp
+}
+
+func newTestProviderData() *PanfactumProvider {
+	return &PanfactumProvider{
+		PanfactumProviderModel: &PanfactumProviderModel{
+			Environment: types.StringValue("production"),
+			Region:      types.StringValue("us-east-2"),
+			IsLocal:     types.BoolValue(false),
+			SLATarget:   types.Int32Value(3),
+			ExtraTags:   types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+		KubeConfigPath: "testdata/kubeconfig.yaml",
+	}
+}
+
+func assertDiagnostics(t *testing.T, name string, actual diag.Diagnostics, expected ...diag.Diagnostic) {
+	t.Helper()
+	if !actual.Equal(expected) {
+		t.Errorf("%s: expected diagnostics %v, got %v", name, diag.Diagnostics(expected), actual)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+/**************************************************************
+  Hash Alphabets
+ **************************************************************/
+
+const (
+	HashAlphabetHex    = "hex"
+	HashAlphabetBase32 = "base32"
+	HashAlphabetBase36 = "base36"
+)
+
+// hashAlphabets encode a SHA-256 digest using only characters that are valid in DNS labels,
+// Kubernetes names, and AWS resource names. Every encoding has a fixed width so that
+// prefixes of the same length are always equally unique.
+var hashAlphabets = map[string]func(digest []byte) string{
+	// 64 characters of 0-9a-f
+	HashAlphabetHex: hex.EncodeToString,
+
+	// 52 characters of a-z2-7 (RFC 4648, lowercase without padding)
+	HashAlphabetBase32: func(digest []byte) string {
+		return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(digest))
+	},
+
+	// 50 characters of 0-9a-z
+	HashAlphabetBase36: func(digest []byte) string {
+		encoded := new(big.Int).SetBytes(digest).Text(36)
+		return strings.Repeat("0", 50-len(encoded)) + encoded
+	},
+}
+
+func hashAlphabetNames() []string {
+	names := make([]string, 0, len(hashAlphabets))
+	for name := range hashAlphabets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/**************************************************************
+  Hashing
+ **************************************************************/
+
+const (
+	stableIDLength   = 16
+	stableIDAlphabet = HashAlphabetBase36
+
+	// Length of the hash suffix appended to sanitized values that have been truncated
+	sanitizeHashLength = 6
+)
+
+// encodeHash returns the SHA-256 hash of the input encoded with the given alphabet
+func encodeHash(input string, encode func(digest []byte) string) string {
+	sum := sha256.Sum256([]byte(input))
+	return encode(sum[:])
+}
+
+// shortHash returns the first length characters of the SHA-256 hash of the input encoded with
+// the given alphabet. Errors point at the arguments of the short_hash function.
+func shortHash(input string, length int, alphabet string) (string, error) {
+	encode, ok := hashAlphabets[alphabet]
+	if !ok {
+		return "", newArgumentError(2, "'%s' is not a supported alphabet (%s)", alphabet, strings.Join(hashAlphabetNames(), ", "))
+	}
+
+	encoded := encodeHash(input, encode)
+	if length < 1 || length > len(encoded) {
+		return "", newArgumentError(1, "length must be between 1 and %d for the %s alphabet", len(encoded), alphabet)
+	}
+
+	return encoded[:length], nil
+}
+
+// hashSuffix returns the first length characters of the hex-encoded SHA-256 hash of the
+// input, up to the 64 characters of the full hash. It is used to keep truncated names
+// unique and stable across runs.
+func hashSuffix(input string, length int) string {
+	encoded := encodeHash(input, hex.EncodeToString)
+	return encoded[:min(max(length, 0), len(encoded))]
+}
+
+// stableID returns a short identifier derived from the given parts. The parts are
+// JSON-encoded before hashing so that, for example, ["a-b"] and ["a", "b"] do not collide.
+func stableID(parts []string) string {
+	if parts == nil {
+		parts = []string{}
+	}
+	// json.Marshal only fails for values that cannot be represented in JSON, which a []string never contains
+	encoded, _ := json.Marshal(parts)
+	return encodeHash(string(encoded), hashAlphabets[stableIDAlphabet])[:stableIDLength]
+}
+
+// truncateWithHash shortens the value to maxLength characters by replacing its end with '-'
+// and a hash of hashInput. Trailing characters that are not letters or numbers are removed
+// from the shortened value before the suffix is appended. Values that already fit are
+// returned unchanged.
+func truncateWithHash(value string, maxLength int, hashInput string) string {
+	if len(value) <= maxLength {
+		return value
+	}
+
+	suffix := hashSuffix(hashInput, sanitizeHashLength)
+	if maxLength <= len(suffix) {
+		return suffix[:maxLength]
+	}
+
+	prefix := strings.TrimRightFunc(value[:maxLength-len(suffix)-1], func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if prefix == "" {
+		return suffix
+	}
+	return prefix + "-" + suffix
+}
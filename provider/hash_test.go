@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestShortHash(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		alphabet  string
+		maxLength int
+		pattern   *regexp.Regexp
+	}{
+		{HashAlphabetHex, 64, regexp.MustCompile(`^[0-9a-f]+$`)},
+		{HashAlphabetBase32, 52, regexp.MustCompile(`^[a-z2-7]+$`)},
+		{HashAlphabetBase36, 50, regexp.MustCompile(`^[0-9a-z]+$`)},
+	}
+
+	for _, test := range tests {
+		for _, input := range []string{"", "a", "production-primary", strings.Repeat("x", 1000)} {
+			full, err := shortHash(input, test.maxLength, test.alphabet)
+			if err != nil {
+				t.Fatalf("shortHash(%q, %d, %q) returned error: %v", input, test.maxLength, test.alphabet, err)
+			}
+			if len(full) != test.maxLength || !test.pattern.MatchString(full) {
+				t.Errorf("shortHash(%q, %d, %q) = %q, expected %d characters matching %s", input, test.maxLength, test.alphabet, full, test.maxLength, test.pattern)
+			}
+
+			short, err := shortHash(input, 8, test.alphabet)
+			if err != nil {
+				t.Fatalf("shortHash(%q, 8, %q) returned error: %v", input, test.alphabet, err)
+			}
+			if short != full[:8] {
+				t.Errorf("shortHash(%q, 8, %q) = %q, expected a prefix of %q", input, test.alphabet, short, full)
+			}
+		}
+
+		if _, err := shortHash("a", 0, test.alphabet); err == nil {
+			t.Errorf("shortHash with length 0 and alphabet %q expected an error", test.alphabet)
+		}
+		if _, err := shortHash("a", test.maxLength+1, test.alphabet); err == nil {
+			t.Errorf("shortHash with length %d and alphabet %q expected an error", test.maxLength+1, test.alphabet)
+		}
+	}
+
+	if _, err := shortHash("a", 8, "base64"); err == nil {
+		t.Errorf("shortHash with alphabet base64 expected an error")
+	}
+
+	// hex must remain the prefix of the SHA-256 hex digest so existing names do not change
+	if result := hashSuffix("hello", 6); result != "2cf24d" {
+		t.Errorf("hashSuffix(\"hello\", 6) = %q, expected %q", result, "2cf24d")
+	}
+	if result := hashSuffix("hello", 100); len(result) != 64 {
+		t.Errorf("hashSuffix(\"hello\", 100) = %q, expected the full 64 character hash", result)
+	}
+	if result := hashSuffix("hello", -1); result != "" {
+		t.Errorf("hashSuffix(\"hello\", -1) = %q, expected an empty string", result)
+	}
+}
+
+func TestStableID(t *testing.T) {
+	t.Parallel()
+
+	// stableID slices the encoded hash without checking the alphabet or length, so they must be valid
+	if _, err := shortHash("", stableIDLength, stableIDAlphabet); err != nil {
+		t.Fatalf("stableIDLength %d is not valid for the %s alphabet: %v", stableIDLength, stableIDAlphabet, err)
+	}
+
+	id := stableID([]string{"a", "b"})
+	if len(id) != stableIDLength || !regexp.MustCompile(`^[0-9a-z]+$`).MatchString(id) {
+		t.Errorf("stableID = %q, expected %d base36 characters", id, stableIDLength)
+	}
+	if stableID([]string{"a", "b"}) != id {
+		t.Errorf("stableID is not deterministic")
+	}
+
+	distinct := [][]string{nil, {""}, {"a", "b"}, {"b", "a"}, {"a-b"}, {"a", "", "b"}, {"ab"}}
+	seen := map[string][]string{}
+	for _, parts := range distinct {
+		id := stableID(parts)
+		if previous, ok := seen[id]; ok {
+			t.Errorf("stableID(%q) collides with stableID(%q)", parts, previous)
+		}
+		seen[id] = parts
+	}
+}
+
+func TestTruncateWithHash(t *testing.T) {
+	t.Parallel()
+
+	if result := truncateWithHash("short", 10, "short"); result != "short" {
+		t.Errorf("truncateWithHash should not change values that fit, got %q", result)
+	}
+
+	result := truncateWithHash("abcdefghij.klmnop", 12, "input")
+	if result != "abcde-"+hashSuffix("input", sanitizeHashLength) {
+		t.Errorf("truncateWithHash = %q", result)
+	}
+
+	result = truncateWithHash("abcd..---xyz", 11, "input")
+	if result != "abcd-"+hashSuffix("input", sanitizeHashLength) {
+		t.Errorf("truncateWithHash should trim non-alphanumeric characters before the suffix, got %q", result)
+	}
+
+	result = truncateWithHash("...........", 10, "input")
+	if result != hashSuffix("input", sanitizeHashLength) {
+		t.Errorf("truncateWithHash should return only the suffix when nothing else remains, got %q", result)
+	}
+}
+
+func TestSanitizeTruncation(t *testing.T) {
+	t.Parallel()
+
+	labelValueRegex := regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?$`)
+
+	long := strings.Repeat("a", 100)
+	value := sanitizeKubeLabelValue(long)
+	if len(value) != kubeLabelNameMaxLength || !labelValueRegex.MatchString(value) {
+		t.Errorf("sanitizeKubeLabelValue(%q) = %q, expected a valid 63 character label value", long, value)
+	}
+	if other := sanitizeKubeLabelValue(long + "b"); other == value {
+		t.Errorf("sanitizeKubeLabelValue should produce different values for different long inputs")
+	}
+	if result := sanitizeKubeLabelValue("short value"); result != "short.value" {
+		t.Errorf("sanitizeKubeLabelValue(\"short value\") = %q, expected %q", result, "short.value")
+	}
+
+	key := sanitizeKubeLabelKey(strings.Repeat("p", 300) + "/" + long)
+	prefix, name, _ := strings.Cut(key, "/")
+	if len(prefix) != kubeLabelPrefixMaxLength || len(name) != kubeLabelNameMaxLength || !labelValueRegex.MatchString(name) {
+		t.Errorf("sanitizeKubeLabelKey produced an invalid key %q", key)
+	}
+	if result := sanitizeKubeLabelKey("panfactum.com/module"); result != "panfactum.com/module" {
+		t.Errorf("sanitizeKubeLabelKey(\"panfactum.com/module\") = %q", result)
+	}
+
+	if result := sanitizeAWSTagKey(strings.Repeat("k", 200)); len(result) != awsTagKeyMaxLength {
+		t.Errorf("sanitizeAWSTagKey produced a %d character key", len(result))
+	}
+	if result := sanitizeAWSTagValue(strings.Repeat("v", 300)); len(result) != awsTagValueMaxLength {
+		t.Errorf("sanitizeAWSTagValue produced a %d character value", len(result))
+	}
+	if result := sanitizeAWSTagValue("a value"); result != "a.value" {
+		t.Errorf("sanitizeAWSTagValue(\"a value\") = %q, expected %q", result, "a.value")
+	}
+}
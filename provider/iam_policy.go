@@ -0,0 +1,694 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/**************************************************************
+  Policy Documents
+ **************************************************************/
+
+const (
+	iamPolicyVersion = "2012-10-17"
+
+	// IAM does not count whitespace towards this limit
+	iamManagedPolicyMaxLength = 6144
+)
+
+var (
+	iamPolicyVersions      = []string{"2012-10-17", "2008-10-17"}
+	iamPolicyKeys          = []string{"Version", "Id", "Statement"}
+	iamPolicyStatementKeys = []string{"Sid", "Effect", "Principal", "NotPrincipal", "Action", "NotAction", "Resource", "NotResource", "Condition"}
+
+	iamPolicyActionRegex = regexp.MustCompile(`^(\*|[a-zA-Z0-9-]+:[a-zA-Z0-9*?]+)$`)
+	iamPolicySidRegex    = regexp.MustCompile(`^[a-zA-Z0-9]*$`)
+)
+
+type iamPolicy struct {
+	Version   string               `json:"Version,omitempty"`
+	ID        string               `json:"Id,omitempty"`
+	Statement []iamPolicyStatement `json:"Statement"`
+}
+
+type iamPolicyStatement struct {
+	Sid          string                                `json:"Sid,omitempty"`
+	Effect       string                                `json:"Effect"`
+	Principal    *iamPolicyPrincipal                   `json:"Principal,omitempty"`
+	NotPrincipal *iamPolicyPrincipal                   `json:"NotPrincipal,omitempty"`
+	Action       iamPolicyValues                       `json:"Action,omitempty"`
+	NotAction    iamPolicyValues                       `json:"NotAction,omitempty"`
+	Resource     iamPolicyValues                       `json:"Resource,omitempty"`
+	NotResource  iamPolicyValues                       `json:"NotResource,omitempty"`
+	Condition    map[string]map[string]iamPolicyValues `json:"Condition,omitempty"`
+}
+
+// iamPolicyValues is a policy element that may be either a single value or a list of values.
+// Numbers and booleans (e.g., in conditions) are stored in their string form.
+type iamPolicyValues []string
+
+func (v *iamPolicyValues) UnmarshalJSON(data []byte) error {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var items []any
+	if list, isList := raw.([]any); isList {
+		items = list
+	} else {
+		items = []any{raw}
+	}
+
+	values := make(iamPolicyValues, 0, len(items))
+	for _, item := range items {
+		switch typed := item.(type) {
+		case string:
+			values = append(values, typed)
+		case bool:
+			values = append(values, strconv.FormatBool(typed))
+		case float64:
+			values = append(values, strconv.FormatFloat(typed, 'f', -1, 64))
+		default:
+			return fmt.Errorf("expected a string or a list of strings")
+		}
+	}
+	*v = values
+	return nil
+}
+
+func (v iamPolicyValues) MarshalJSON() ([]byte, error) {
+	if len(v) == 1 {
+		return json.Marshal(v[0])
+	}
+	return json.Marshal([]string(v))
+}
+
+// iamPolicyPrincipal is either the wildcard '*' or a map of principal types (e.g., AWS or Service)
+// to principals
+type iamPolicyPrincipal struct {
+	wildcard bool
+	values   map[string]iamPolicyValues
+}
+
+func (p *iamPolicyPrincipal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		if wildcard != "*" {
+			return fmt.Errorf("expected '*' or a map of principal types to principals")
+		}
+		p.wildcard = true
+		return nil
+	}
+	if err := json.Unmarshal(data, &p.values); err != nil {
+		return fmt.Errorf("expected '*' or a map of principal types to principals")
+	}
+	return nil
+}
+
+func (p iamPolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if p.wildcard {
+		return json.Marshal("*")
+	}
+	return json.Marshal(p.values)
+}
+
+/**************************************************************
+  Findings
+ **************************************************************/
+
+const (
+	iamPolicyFindingError   = "error"
+	iamPolicyFindingWarning = "warning"
+)
+
+type iamPolicyFinding struct {
+	Severity string `tfsdk:"severity"`
+	Code     string `tfsdk:"code"`
+	Path     string `tfsdk:"path"`
+	Message  string `tfsdk:"message"`
+}
+
+var iamPolicyFindingAttrTypes = map[string]attr.Type{
+	"severity": types.StringType,
+	"code":     types.StringType,
+	"path":     types.StringType,
+	"message":  types.StringType,
+}
+
+type iamPolicyValidation struct {
+	Valid    bool               `tfsdk:"valid"`
+	Size     int64              `tfsdk:"size"`
+	Findings []iamPolicyFinding `tfsdk:"findings"`
+}
+
+var iamPolicyValidationAttrTypes = map[string]attr.Type{
+	"valid":    types.BoolType,
+	"size":     types.Int64Type,
+	"findings": types.ListType{ElemType: types.ObjectType{AttrTypes: iamPolicyFindingAttrTypes}},
+}
+
+type iamPolicyFindings []iamPolicyFinding
+
+func (f *iamPolicyFindings) add(severity string, code string, path string, format string, args ...any) {
+	*f = append(*f, iamPolicyFinding{
+		Severity: severity,
+		Code:     code,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (f iamPolicyFindings) hasErrors() bool {
+	for _, finding := range f {
+		if finding.Severity == iamPolicyFindingError {
+			return true
+		}
+	}
+	return false
+}
+
+// errorSummary joins the messages of every error finding
+func (f iamPolicyFindings) errorSummary() string {
+	var messages []string
+	for _, finding := range f {
+		if finding.Severity == iamPolicyFindingError {
+			if finding.Path != "" {
+				messages = append(messages, fmt.Sprintf("%s: %s", finding.Path, finding.Message))
+			} else {
+				messages = append(messages, finding.Message)
+			}
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+/**************************************************************
+  Parsing and Validation
+ **************************************************************/
+
+// parseIAMPolicy decodes a policy document and checks its structure. The returned policy is
+// nil if the document could not be decoded at all.
+func parseIAMPolicy(document string) (*iamPolicy, iamPolicyFindings) {
+	var findings iamPolicyFindings
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		findings.add(iamPolicyFindingError, "invalid_json", "", "The policy is not a valid JSON object: %s", err)
+		return nil, findings
+	}
+
+	for _, key := range sortedKeys(raw) {
+		if !containsString(iamPolicyKeys, key) {
+			findings.add(iamPolicyFindingError, "unknown_element", key, "'%s' is not a valid policy element (%s)", key, strings.Join(iamPolicyKeys, ", "))
+		}
+	}
+
+	policy := iamPolicy{}
+
+	if value, ok := raw["Version"]; !ok {
+		findings.add(iamPolicyFindingWarning, "missing_version", "Version", "The policy does not specify a Version, so it defaults to 2008-10-17 which does not support policy variables. Set Version to %s.", iamPolicyVersion)
+	} else if err := json.Unmarshal(value, &policy.Version); err != nil || !containsString(iamPolicyVersions, policy.Version) {
+		findings.add(iamPolicyFindingError, "invalid_version", "Version", "Version must be one of: %s", strings.Join(iamPolicyVersions, ", "))
+	}
+
+	if value, ok := raw["Id"]; ok {
+		if err := json.Unmarshal(value, &policy.ID); err != nil {
+			findings.add(iamPolicyFindingError, "invalid_element", "Id", "Id must be a string")
+		}
+	}
+
+	value, ok := raw["Statement"]
+	if !ok {
+		findings.add(iamPolicyFindingError, "missing_statement", "Statement", "The policy does not have a Statement")
+		return &policy, findings
+	}
+
+	var rawStatements []json.RawMessage
+	if trimmed := bytes.TrimSpace(value); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &rawStatements); err != nil {
+			findings.add(iamPolicyFindingError, "invalid_element", "Statement", "Statement must be an object or a list of objects")
+			return &policy, findings
+		}
+	} else {
+		rawStatements = []json.RawMessage{value}
+	}
+
+	if len(rawStatements) == 0 {
+		findings.add(iamPolicyFindingError, "missing_statement", "Statement", "The policy does not have any statements")
+	}
+
+	sids := map[string]bool{}
+	for i, rawStatement := range rawStatements {
+		statement, statementFindings := parseIAMPolicyStatement(rawStatement, fmt.Sprintf("Statement[%d]", i))
+		findings = append(findings, statementFindings...)
+		if statement == nil {
+			continue
+		}
+
+		if statement.Sid != "" {
+			if sids[statement.Sid] {
+				findings.add(iamPolicyFindingError, "duplicate_sid", fmt.Sprintf("Statement[%d].Sid", i), "The Sid '%s' is used by more than one statement", statement.Sid)
+			}
+			sids[statement.Sid] = true
+		}
+
+		policy.Statement = append(policy.Statement, *statement)
+	}
+
+	return &policy, findings
+}
+
+func parseIAMPolicyStatement(data json.RawMessage, path string) (*iamPolicyStatement, iamPolicyFindings) {
+	var findings iamPolicyFindings
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		findings.add(iamPolicyFindingError, "invalid_element", path, "The statement must be an object")
+		return nil, findings
+	}
+
+	for _, key := range sortedKeys(raw) {
+		if !containsString(iamPolicyStatementKeys, key) {
+			findings.add(iamPolicyFindingError, "unknown_element", path+"."+key, "'%s' is not a valid statement element (%s)", key, strings.Join(iamPolicyStatementKeys, ", "))
+		}
+	}
+
+	statement := iamPolicyStatement{}
+	invalid := map[string]bool{}
+	decode := func(key string, target any, expected string) {
+		if value, ok := raw[key]; ok {
+			if err := json.Unmarshal(value, target); err != nil {
+				invalid[key] = true
+				findings.add(iamPolicyFindingError, "invalid_element", path+"."+key, "%s must be %s", key, expected)
+			}
+		}
+	}
+	decode("Sid", &statement.Sid, "a string")
+	decode("Effect", &statement.Effect, "a string")
+	decode("Principal", &statement.Principal, "'*' or a map of principal types to principals")
+	decode("NotPrincipal", &statement.NotPrincipal, "'*' or a map of principal types to principals")
+	decode("Action", &statement.Action, "a string or a list of strings")
+	decode("NotAction", &statement.NotAction, "a string or a list of strings")
+	decode("Resource", &statement.Resource, "a string or a list of strings")
+	decode("NotResource", &statement.NotResource, "a string or a list of strings")
+	decode("Condition", &statement.Condition, "a map of condition operators to maps of condition keys to values")
+
+	if !iamPolicySidRegex.MatchString(statement.Sid) {
+		findings.add(iamPolicyFindingError, "invalid_sid", path+".Sid", "The Sid '%s' must only contain alphanumeric characters", statement.Sid)
+	}
+
+	if _, ok := raw["Effect"]; !ok {
+		findings.add(iamPolicyFindingError, "missing_effect", path+".Effect", "The statement does not have an Effect")
+	} else if statement.Effect != "Allow" && statement.Effect != "Deny" {
+		findings.add(iamPolicyFindingError, "invalid_effect", path+".Effect", "Effect must be Allow or Deny, not '%s'", statement.Effect)
+	}
+
+	exclusive := func(key string, notKey string, required bool) {
+		_, hasKey := raw[key]
+		_, hasNotKey := raw[notKey]
+		if hasKey && hasNotKey {
+			findings.add(iamPolicyFindingError, "conflicting_elements", path, "The statement cannot have both %s and %s", key, notKey)
+		} else if required && !hasKey && !hasNotKey {
+			findings.add(iamPolicyFindingError, "missing_"+strings.ToLower(key), path, "The statement must have either %s or %s", key, notKey)
+		}
+	}
+	exclusive("Principal", "NotPrincipal", false)
+	exclusive("Action", "NotAction", true)
+
+	// Trust policies identify the role by the policy's attachment rather than by a Resource
+	_, hasPrincipal := raw["Principal"]
+	_, hasNotPrincipal := raw["NotPrincipal"]
+	exclusive("Resource", "NotResource", !hasPrincipal && !hasNotPrincipal)
+
+	for _, key := range []string{"Action", "NotAction"} {
+		values := statement.Action
+		if key == "NotAction" {
+			values = statement.NotAction
+		}
+		if _, ok := raw[key]; ok && !invalid[key] && len(values) == 0 {
+			findings.add(iamPolicyFindingError, "empty_element", path+"."+key, "%s must not be empty", key)
+		}
+		for _, action := range values {
+			if !iamPolicyActionRegex.MatchString(action) {
+				findings.add(iamPolicyFindingError, "invalid_action", path+"."+key, "'%s' is not a valid action (e.g., s3:GetObject)", action)
+			}
+		}
+	}
+
+	for _, key := range []string{"Resource", "NotResource"} {
+		values := statement.Resource
+		if key == "NotResource" {
+			values = statement.NotResource
+		}
+		if _, ok := raw[key]; ok && !invalid[key] && len(values) == 0 {
+			findings.add(iamPolicyFindingError, "empty_element", path+"."+key, "%s must not be empty", key)
+		}
+		for _, resource := range values {
+			if resource != "*" && !strings.HasPrefix(resource, "arn:") {
+				findings.add(iamPolicyFindingError, "invalid_resource", path+"."+key, "'%s' is not a valid resource (must be '*' or an ARN)", resource)
+			}
+		}
+	}
+
+	return &statement, findings
+}
+
+// checkIAMPolicyWildcards reports Allow statements that grant more access than is likely intended
+func checkIAMPolicyWildcards(policy *iamPolicy) iamPolicyFindings {
+	var findings iamPolicyFindings
+
+	for i, statement := range policy.Statement {
+		if statement.Effect != "Allow" {
+			continue
+		}
+		path := fmt.Sprintf("Statement[%d]", i)
+
+		for _, action := range statement.Action {
+			if action == "*" {
+				findings.add(iamPolicyFindingWarning, "wildcard_action", path+".Action", "The statement allows every action")
+			} else if strings.HasSuffix(action, ":*") {
+				findings.add(iamPolicyFindingWarning, "service_wildcard_action", path+".Action", "The statement allows every %s action", strings.TrimSuffix(action, ":*"))
+			}
+		}
+
+		if len(statement.NotAction) > 0 {
+			findings.add(iamPolicyFindingWarning, "allow_not_action", path+".NotAction", "The statement allows every action except those listed in NotAction")
+		}
+
+		if containsString(statement.Resource, "*") {
+			findings.add(iamPolicyFindingWarning, "wildcard_resource", path+".Resource", "The statement applies to every resource")
+		}
+
+		if len(statement.NotResource) > 0 {
+			findings.add(iamPolicyFindingWarning, "allow_not_resource", path+".NotResource", "The statement applies to every resource except those listed in NotResource")
+		}
+
+		if statement.Principal != nil && len(statement.Condition) == 0 {
+			if statement.Principal.wildcard || containsString(statement.Principal.values["AWS"], "*") {
+				findings.add(iamPolicyFindingWarning, "wildcard_principal", path+".Principal", "The statement allows every principal without any Condition")
+			}
+		}
+	}
+
+	return findings
+}
+
+// validateIAMPolicy checks the structure, wildcard usage, and size of a policy document
+func validateIAMPolicy(document string) iamPolicyValidation {
+	policy, findings := parseIAMPolicy(document)
+
+	size := iamPolicySize(document)
+	if policy != nil {
+		findings = append(findings, checkIAMPolicyWildcards(policy)...)
+		if size > iamManagedPolicyMaxLength {
+			findings.add(iamPolicyFindingError, "policy_too_large", "", "The policy is %d characters (excluding whitespace), which exceeds the %d character limit for managed policies", size, iamManagedPolicyMaxLength)
+		}
+	}
+
+	if findings == nil {
+		findings = iamPolicyFindings{}
+	}
+
+	return iamPolicyValidation{
+		Valid:    !findings.hasErrors(),
+		Size:     int64(size),
+		Findings: findings,
+	}
+}
+
+// iamPolicySize returns the number of characters that IAM counts towards the policy size limits
+func iamPolicySize(document string) int {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(document)); err != nil {
+		return utf8.RuneCountInString(document)
+	}
+	return utf8.RuneCount(compacted.Bytes())
+}
+
+/**************************************************************
+  Merging
+ **************************************************************/
+
+// mergeIAMPolicies combines the statements of multiple policy documents into a single
+// minified document. Statements are merged only when the result grants exactly the same
+// access as the originals:
+//
+//   - they must have the same Sid, Effect, Principal, NotPrincipal, and Condition, and
+//   - either their actions are identical (and Resource lists are combined) or their
+//     resources are identical (and Action lists are combined).
+//
+// Statements that share a Sid but cannot be merged are reported as an error.
+func mergeIAMPolicies(documents []string) (string, error) {
+	var statements []iamPolicyStatement
+	var ids []string
+
+	for i, document := range documents {
+		policy, findings := parseIAMPolicy(document)
+		if findings.hasErrors() {
+			return "", fmt.Errorf("document %d is not a valid policy: %s", i, findings.errorSummary())
+		}
+		if policy.ID != "" && !containsString(ids, policy.ID) {
+			ids = append(ids, policy.ID)
+		}
+		for _, statement := range policy.Statement {
+			statements = append(statements, normalizeIAMPolicyStatement(statement))
+		}
+	}
+
+	if len(statements) == 0 {
+		return "", fmt.Errorf("at least one policy document is required")
+	}
+
+	for merged := true; merged; {
+		merged = false
+		for i := 0; i < len(statements) && !merged; i++ {
+			for j := i + 1; j < len(statements) && !merged; j++ {
+				if combined, ok := mergeIAMPolicyStatements(statements[i], statements[j]); ok {
+					statements[i] = combined
+					statements = append(statements[:j], statements[j+1:]...)
+					merged = true
+				}
+			}
+		}
+	}
+
+	sids := map[string]bool{}
+	for _, statement := range statements {
+		if statement.Sid != "" {
+			if sids[statement.Sid] {
+				return "", fmt.Errorf("multiple statements use the Sid '%s' but cannot be merged as they have different effects, principals, conditions, or both different actions and resources", statement.Sid)
+			}
+			sids[statement.Sid] = true
+		}
+	}
+
+	policy := iamPolicy{
+		Version:   iamPolicyVersion,
+		Statement: statements,
+	}
+	if len(ids) == 1 {
+		policy.ID = ids[0]
+	}
+
+	result, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+func mergeIAMPolicyStatements(a, b iamPolicyStatement) (iamPolicyStatement, bool) {
+	if a.Sid != b.Sid || a.Effect != b.Effect ||
+		!iamPolicyJSONEqual(a.Principal, b.Principal) ||
+		!iamPolicyJSONEqual(a.NotPrincipal, b.NotPrincipal) ||
+		!iamPolicyJSONEqual(a.Condition, b.Condition) ||
+		!iamPolicyValuesEqual(a.NotAction, b.NotAction, true) ||
+		!iamPolicyValuesEqual(a.NotResource, b.NotResource, false) ||
+		(a.Action == nil) != (b.Action == nil) ||
+		(a.Resource == nil) != (b.Resource == nil) {
+		return a, false
+	}
+
+	sameActions := iamPolicyValuesEqual(a.Action, b.Action, true)
+	sameResources := iamPolicyValuesEqual(a.Resource, b.Resource, false)
+
+	switch {
+	case sameActions && sameResources:
+		return a, true
+	case sameActions && a.Resource != nil:
+		a.Resource = dedupeIAMPolicyValues(append(append(iamPolicyValues{}, a.Resource...), b.Resource...), false)
+		return a, true
+	case sameResources && a.Action != nil:
+		a.Action = dedupeIAMPolicyValues(append(append(iamPolicyValues{}, a.Action...), b.Action...), true)
+		return a, true
+	default:
+		return a, false
+	}
+}
+
+// normalizeIAMPolicyStatement sorts and de-duplicates every list in the statement so that
+// equivalent statements compare as equal
+func normalizeIAMPolicyStatement(statement iamPolicyStatement) iamPolicyStatement {
+	statement.Action = dedupeIAMPolicyValues(statement.Action, true)
+	statement.NotAction = dedupeIAMPolicyValues(statement.NotAction, true)
+	statement.Resource = dedupeIAMPolicyValues(statement.Resource, false)
+	statement.NotResource = dedupeIAMPolicyValues(statement.NotResource, false)
+
+	for _, principal := range []*iamPolicyPrincipal{statement.Principal, statement.NotPrincipal} {
+		if principal != nil {
+			for key, values := range principal.values {
+				principal.values[key] = sortIAMPolicyValues(values)
+			}
+		}
+	}
+
+	for _, keys := range statement.Condition {
+		for key, values := range keys {
+			keys[key] = sortIAMPolicyValues(values)
+		}
+	}
+
+	return statement
+}
+
+// dedupeIAMPolicyValues sorts the values and removes any that are duplicates of, or are matched by
+// a wildcard in, another value. Actions are case-insensitive.
+func dedupeIAMPolicyValues(values iamPolicyValues, caseInsensitive bool) iamPolicyValues {
+	if values == nil {
+		return nil
+	}
+
+	fold := func(value string) string {
+		if caseInsensitive {
+			return strings.ToLower(value)
+		}
+		return value
+	}
+
+	var unique iamPolicyValues
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[fold(value)] {
+			seen[fold(value)] = true
+			unique = append(unique, value)
+		}
+	}
+
+	result := iamPolicyValues{}
+	for i, value := range unique {
+		redundant := false
+		for j, other := range unique {
+			if i != j && iamPolicyWildcardCovers(fold(other), fold(value)) {
+				// If two patterns cover each other, keep the one that sorts first
+				if !iamPolicyWildcardCovers(fold(value), fold(other)) || fold(other) < fold(value) {
+					redundant = true
+					break
+				}
+			}
+		}
+		if !redundant {
+			result = append(result, value)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return fold(result[i]) < fold(result[j])
+	})
+	return result
+}
+
+// iamPolicyWildcardCovers returns true if every string matched by value is also matched by pattern
+func iamPolicyWildcardCovers(pattern string, value string) bool {
+	if !strings.ContainsAny(pattern, "*?") {
+		return false
+	}
+
+	// Matching the literal text of a value that has wildcards is only sound if the pattern's
+	// wildcards can match any sequence of characters
+	if strings.ContainsAny(value, "*?") && strings.Contains(pattern, "?") {
+		return false
+	}
+
+	return iamPolicyWildcardMatch(pattern, value)
+}
+
+// iamPolicyWildcardMatch matches a value against a pattern where '*' matches any sequence of
+// characters and '?' matches any single character
+func iamPolicyWildcardMatch(pattern string, value string) bool {
+	p, v := []rune(pattern), []rune(value)
+	pi, vi := 0, 0
+	starPi, starVi := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			starPi, starVi = pi, vi
+			pi++
+		case starPi >= 0:
+			pi = starPi + 1
+			starVi++
+			vi = starVi
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+func sortIAMPolicyValues(values iamPolicyValues) iamPolicyValues {
+	var unique iamPolicyValues
+	for _, value := range values {
+		if !containsString(unique, value) {
+			unique = append(unique, value)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// iamPolicyValuesEqual compares two normalized (sorted and de-duplicated) lists of values
+func iamPolicyValuesEqual(a iamPolicyValues, b iamPolicyValues, caseInsensitive bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] && !(caseInsensitive && strings.EqualFold(a[i], b[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func iamPolicyJSONEqual(a any, b any) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(aJSON, bJSON)
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = IAMPolicyMergeFunction{}
+)
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return IAMPolicyMergeFunction{}
+}
+
+type IAMPolicyMergeFunction struct{}
+
+func (f IAMPolicyMergeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f IAMPolicyMergeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a single minified IAM policy document that combines the statements of multiple documents",
+		Description: "Duplicate actions and resources (including those already matched by a wildcard such as s3:*) are removed. Statements with the same Sid, Effect, Principal, and Condition are merged when they have identical actions (by combining their resources) or identical resources (by combining their actions), so the merged policy grants exactly the same access. Statements that share a Sid but cannot be merged are an error. Use iam_policy_validate to check the size of the result.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType:        types.StringType,
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The IAM policy documents (JSON) to merge",
+				Name:               "documents",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f IAMPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var documents []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documents))
+	if resp.Error != nil {
+		return
+
+	}
+
+	merged, err := mergeIAMPolicies(documents)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	tflog.Debug(ctx, "Merged IAM policies", map[string]any{"documents": len(documents), "size": iamPolicySize(merged)})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, merged))
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMergeIAMPolicies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		documents []string
+		expected  string
+	}{
+		{
+			name: "combines resources of statements with the same actions",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`,
+				`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"arn:aws:s3:::b/*"}}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]}]}`,
+		},
+		{
+			name: "combines actions of statements with the same resources",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::a/*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"arn:aws:s3:::a/*"}]}`,
+		},
+		{
+			name: "does not combine statements that differ in both actions and resources",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`,
+		},
+		{
+			name: "removes duplicate and wildcard-matched values",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","S3:getobject","s3:Get*","s3:List*"],"Resource":["arn:aws:s3:::a/*","arn:aws:s3:::a/b","arn:aws:s3:::a/*"]}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:Get*","s3:List*"],"Resource":"arn:aws:s3:::a/*"}]}`,
+		},
+		{
+			name: "does not combine statements with different conditions or effects",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*","Condition":{"Bool":{"aws:SecureTransport":true}}}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::b/*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::c/*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*","Condition":{"Bool":{"aws:SecureTransport":"true"}}},{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::b/*"},{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::c/*"}]}`,
+		},
+		{
+			name: "combines statements with the same Sid and conditions",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*","Condition":{"StringEquals":{"aws:PrincipalTag/team":["a","b"]}}}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::b/*","Condition":{"StringEquals":{"aws:PrincipalTag/team":["b","a"]}}}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"],"Condition":{"StringEquals":{"aws:PrincipalTag/team":["a","b"]}}}]}`,
+		},
+		{
+			name: "does not combine the union of NotAction lists",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"sts:*","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":"*"},{"Effect":"Deny","NotAction":"sts:*","Resource":"*"}]}`,
+		},
+		{
+			name: "keeps principals",
+			documents: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:TagSession"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":["sts:AssumeRole","sts:TagSession"]}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := mergeIAMPolicies(test.documents)
+		if err != nil {
+			t.Fatalf("%s: mergeIAMPolicies returned error: %v", test.name, err)
+		}
+		if result != test.expected {
+			t.Errorf("%s: mergeIAMPolicies = %s, expected %s", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestMergeIAMPoliciesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := [][]string{
+		{},
+		{`not json`},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Maybe","Action":"s3:GetObject","Resource":"*"}]}`},
+		{
+			`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`,
+			`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::b/*"}]}`,
+		},
+	}
+
+	for _, documents := range tests {
+		if _, err := mergeIAMPolicies(documents); err == nil {
+			t.Errorf("mergeIAMPolicies(%q) expected an error", documents)
+		}
+	}
+}
+
+func TestValidateIAMPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		document string
+		valid    bool
+		codes    []string
+	}{
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`, true, nil},
+		{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`, true, []string{"missing_version"}},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`, true, []string{"wildcard_action", "wildcard_resource"}},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`, true, []string{"service_wildcard_action", "wildcard_resource"}},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","NotResource":"arn:aws:s3:::a"}]}`, true, []string{"allow_not_action", "allow_not_resource"}},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`, true, []string{"wildcard_principal"}},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-123"}}}]}`, true, nil},
+		{`[]`, false, []string{"invalid_json"}},
+		{`{"Version":"2012-10-17"}`, false, []string{"missing_statement"}},
+		{`{"Version":"2020-01-01","Statement":[]}`, false, []string{"invalid_version", "missing_statement"}},
+		{`{"Version":"2012-10-17","Extra":1,"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*","Extra":1}]}`, false, []string{"unknown_element", "unknown_element"}},
+		{`{"Version":"2012-10-17","Statement":[{"Sid":"a-b","Action":"s3GetObject","Resource":"bucket"}]}`, false, []string{"invalid_sid", "missing_effect", "invalid_action", "invalid_resource"}},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotAction":"s3:PutObject"}]}`, false, []string{"conflicting_elements", "missing_resource", "allow_not_action"}},
+		{`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`, false, []string{"duplicate_sid"}},
+		{`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":[],"Resource":{"a":"b"}}]}`, false, []string{"invalid_element", "empty_element"}},
+	}
+
+	for _, test := range tests {
+		result := validateIAMPolicy(test.document)
+		if result.Valid != test.valid {
+			t.Errorf("validateIAMPolicy(%s).Valid = %t, expected %t (%v)", test.document, result.Valid, test.valid, result.Findings)
+		}
+		var codes []string
+		for _, finding := range result.Findings {
+			codes = append(codes, finding.Code)
+		}
+		if strings.Join(codes, ",") != strings.Join(test.codes, ",") {
+			t.Errorf("validateIAMPolicy(%s) codes = %v, expected %v", test.document, codes, test.codes)
+		}
+	}
+}
+
+func TestValidateIAMPolicySize(t *testing.T) {
+	t.Parallel()
+
+	statement := `{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/%s"}`
+	document := `{"Version": "2012-10-17", "Statement": [` + strings.Repeat(strings.Replace(statement, "%s", strings.Repeat("a", 100), 1)+", ", 50) + strings.Replace(statement, "%s", "b", 1) + `]}`
+
+	result := validateIAMPolicy(document)
+	if result.Size != int64(iamPolicySize(document)) || int(result.Size) >= len(document) {
+		t.Errorf("validateIAMPolicy size = %d should exclude whitespace (document length %d)", result.Size, len(document))
+	}
+	if result.Valid || result.Findings[len(result.Findings)-1].Code != "policy_too_large" {
+		t.Errorf("validateIAMPolicy expected a policy_too_large finding for a %d character policy", result.Size)
+	}
+
+	// Merging removes the redundant statements and brings the policy under the limit
+	merged, err := mergeIAMPolicies([]string{document})
+	if err != nil {
+		t.Fatalf("mergeIAMPolicies returned error: %v", err)
+	}
+	if result := validateIAMPolicy(merged); !result.Valid {
+		t.Errorf("validateIAMPolicy(merged) expected to be valid: %v", result.Findings)
+	}
+}
+
+func TestIAMPolicyWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern, value string
+		expected       bool
+	}{
+		{"s3:*", "s3:getobject", true},
+		{"s3:get*", "s3:getobject", true},
+		{"s3:get?bject", "s3:getobject", true},
+		{"s3:put*", "s3:getobject", false},
+		{"*", "anything", true},
+		{"a*b*c", "aXbYc", true},
+		{"a*b*c", "aXbY", false},
+	}
+
+	for _, test := range tests {
+		if result := iamPolicyWildcardMatch(test.pattern, test.value); result != test.expected {
+			t.Errorf("iamPolicyWildcardMatch(%q, %q) = %t, expected %t", test.pattern, test.value, result, test.expected)
+		}
+	}
+
+	if iamPolicyWildcardCovers("s3:get?", "s3:get*") {
+		t.Errorf("iamPolicyWildcardCovers should not treat s3:get? as covering s3:get*")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = IAMPolicyValidateFunction{}
+)
+
+func NewIAMPolicyValidateFunction() function.Function {
+	return IAMPolicyValidateFunction{}
+}
+
+type IAMPolicyValidateFunction struct{}
+
+func (f IAMPolicyValidateFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_validate"
+}
+
+func (f IAMPolicyValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the findings from checking the structure, wildcard usage, and size of an IAM policy document",
+		Description: "Returns an object with valid (true if there are no error findings), size (the number of characters excluding whitespace, which is what IAM counts towards the 6144 character managed policy limit), and findings. Each finding has a severity (error or warning), a code (e.g., invalid_action or wildcard_resource), the path of the offending element (e.g., Statement[0].Action), and a message. Invalid documents are reported as findings rather than errors so that they can be used in preconditions.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The IAM policy document (JSON) to validate",
+				Name:               "document",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamPolicyValidationAttrTypes,
+		},
+	}
+}
+
+func (f IAMPolicyValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+
+	}
+
+	result := validateIAMPolicy(document)
+	tflog.Debug(ctx, "Validated IAM policy", map[string]any{
+		"valid":    result.Valid,
+		"size":     result.Size,
+		"findings": len(result.Findings),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"path/filepath"
+)
+
+/**************************************************************
+  Provider Definition
+ **************************************************************/
+
+var _ datasource.DataSourceWithConfigure = &kubeContextDataSource{}
+
+func NewKubeContextDataSource() datasource.DataSource {
+	return &kubeContextDataSource{}
+}
+
+type kubeContextDataSource struct {
+	panfactumDataSource
+}
+
+type kubeContextDataSourceModel struct {
+	Context                  types.String          `tfsdk:"context"`
+	KubeConfigPath           types.String          `tfsdk:"kubeconfig_path"`
+	ClusterName              types.String          `tfsdk:"cluster_name"`
+	Server                   types.String          `tfsdk:"server"`
+	CertificateAuthority     types.String          `tfsdk:"certificate_authority"`
+	CertificateAuthorityData types.String          `tfsdk:"certificate_authority_data"`
+	Namespace                types.String          `tfsdk:"namespace"`
+	User                     types.String          `tfsdk:"user"`
+	Exec                     *kubeContextExecModel `tfsdk:"exec"`
+}
+
+type kubeContextExecModel struct {
+	APIVersion types.String      `tfsdk:"api_version"`
+	Command    types.String      `tfsdk:"command"`
+	Args       []string          `tfsdk:"args"`
+	Env        map[string]string `tfsdk:"env"`
+}
+
+func (d *kubeContextDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kube_context"
+}
+
+func (d *kubeContextDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := kubeContextAttributes()
+	attributes["context"] = schema.StringAttribute{
+		Description:         "The name of the context in the kubeconfig file to look up",
+		MarkdownDescription: "The name of the context in the kubeconfig file to look up",
+		Required:            true,
+	}
+	attributes["kubeconfig_path"] = schema.StringAttribute{
+		Description:         "The path to the kubeconfig file to read. Defaults to the kubeconfig file used by the provider.",
+		MarkdownDescription: "The path to the kubeconfig file to read. Defaults to the kubeconfig file used by the provider.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Provides the cluster and user configuration of an arbitrary context in a kubeconfig file",
+		MarkdownDescription: "Provides the cluster and user configuration of an arbitrary context in a kubeconfig file",
+		Attributes:          attributes,
+	}
+}
+
+func (d *kubeContextDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	// Guard against reads before the provider has been configured
+	if !d.checkProviderConfigured(req, resp) {
+		return
+	}
+
+	var data kubeContextDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Default to the kubeconfig file used by the provider
+	kubeConfigPath := d.ProviderData.KubeConfigPath
+	if !data.KubeConfigPath.IsNull() && !data.KubeConfigPath.IsUnknown() {
+		kubeConfigPath = filepath.Clean(data.KubeConfigPath.ValueString())
+	}
+	data.KubeConfigPath = types.StringValue(kubeConfigPath)
+
+	kubeConfig, err := loadKubeConfig(kubeConfigPath)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("kubeconfig_path"), "Unable to load kubeconfig", fmt.Sprintf("%v", err))
+		return
+	}
+
+	kubeContext, err := kubeConfig.resolveKubeContext(kubeConfigPath, data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("context"), "Unable to load kubeconfig context", fmt.Sprintf("%v", err))
+		return
+	}
+
+	data.ClusterName = optionalStringValue(kubeContext.ClusterName)
+	data.Server = optionalStringValue(kubeContext.Server)
+	data.CertificateAuthority = optionalStringValue(kubeContext.CertificateAuthority)
+	data.CertificateAuthorityData = optionalStringValue(kubeContext.CertificateAuthorityData)
+	data.Namespace = optionalStringValue(kubeContext.Namespace)
+	data.User = optionalStringValue(kubeContext.User)
+	data.Exec = newKubeContextExecModel(kubeContext.Exec)
+
+	tflog.Debug(ctx, "Resolved kubeconfig context", map[string]any{
+		"kube_config_path": kubeConfigPath,
+		"context":          data.Context.ValueString(),
+		"cluster_name":     kubeContext.ClusterName,
+		"server":           kubeContext.Server,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+/**************************************************************
+  Utility Functions
+ **************************************************************/
+
+// kubeContextAttributes returns the computed attributes that describe a resolved
+// kubeconfig context. They are shared by pf_kube_context and pf_kube_contexts.
+func kubeContextAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cluster_name": schema.StringAttribute{
+			Description:         "The name of the Kubernetes cluster referenced by the context",
+			MarkdownDescription: "The name of the Kubernetes cluster referenced by the context",
+			Computed:            true,
+		},
+		"server": schema.StringAttribute{
+			Description:         "The HTTPS address of the Kubernetes API server of the cluster",
+			MarkdownDescription: "The HTTPS address of the Kubernetes API server of the cluster",
+			Computed:            true,
+		},
+		"certificate_authority": schema.StringAttribute{
+			Description:         "The path to the certificate authority file of the cluster, if set",
+			MarkdownDescription: "The path to the certificate authority file of the cluster, if set",
+			Computed:            true,
+		},
+		"certificate_authority_data": schema.StringAttribute{
+			Description:         "The base64-encoded certificate authority data of the cluster, if set",
+			MarkdownDescription: "The base64-encoded certificate authority data of the cluster, if set",
+			Computed:            true,
+		},
+		"namespace": schema.StringAttribute{
+			Description:         "The default namespace of the context, if set",
+			MarkdownDescription: "The default namespace of the context, if set",
+			Computed:            true,
+		},
+		"user": schema.StringAttribute{
+			Description:         "The name of the user referenced by the context",
+			MarkdownDescription: "The name of the user referenced by the context",
+			Computed:            true,
+		},
+		"exec": schema.SingleNestedAttribute{
+			Description:         "The exec credential plugin configuration of the user, if set",
+			MarkdownDescription: "The exec credential plugin configuration of the user, if set",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"api_version": schema.StringAttribute{
+					Description:         "The API version of the ExecCredential resource returned by the plugin",
+					MarkdownDescription: "The API version of the `ExecCredential` resource returned by the plugin",
+					Computed:            true,
+				},
+				"command": schema.StringAttribute{
+					Description:         "The command to execute",
+					MarkdownDescription: "The command to execute",
+					Computed:            true,
+				},
+				"args": schema.ListAttribute{
+					Description:         "The arguments to pass to the command",
+					MarkdownDescription: "The arguments to pass to the command",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"env": schema.MapAttribute{
+					Description:         "The environment variables to set when executing the command",
+					MarkdownDescription: "The environment variables to set when executing the command",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func newKubeContextExecModel(exec *KubeConfigExec) *kubeContextExecModel {
+	if exec == nil {
+		return nil
+	}
+
+	env := map[string]string{}
+	for _, envVar := range exec.Env {
+		env[envVar.Name] = envVar.Value
+	}
+
+	args := exec.Args
+	if args == nil {
+		args = []string{}
+	}
+
+	return &kubeContextExecModel{
+		APIVersion: optionalStringValue(exec.APIVersion),
+		Command:    optionalStringValue(exec.Command),
+		Args:       args,
+		Env:        env,
+	}
+}
+
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestKubeContextDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                data "pf_kube_context" "test" {
+                    context         = "production-primary"
+                    kubeconfig_path = "testdata/kubeconfig.yaml"
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_kube_context.test", tfjsonpath.New("cluster_name"), knownvalue.StringExact("production-primary")),
+					statecheck.ExpectKnownValue("data.pf_kube_context.test", tfjsonpath.New("server"), knownvalue.StringExact("https://primary.example.com")),
+					statecheck.ExpectKnownValue("data.pf_kube_context.test", tfjsonpath.New("certificate_authority_data"), knownvalue.StringExact("Y2VydGlmaWNhdGU=")),
+					statecheck.ExpectKnownValue("data.pf_kube_context.test", tfjsonpath.New("namespace"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("data.pf_kube_context.test", tfjsonpath.New("exec").AtMapKey("command"), knownvalue.StringExact("pf")),
+					statecheck.ExpectKnownValue("data.pf_kube_context.test", tfjsonpath.New("exec").AtMapKey("env"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"AWS_PROFILE": knownvalue.StringExact("production-superuser"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestKubeContextsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                data "pf_kube_contexts" "test" {
+                    kubeconfig_path = "testdata/kubeconfig.yaml"
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_kube_contexts.test", tfjsonpath.New("contexts").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact("production-primary")),
+					statecheck.ExpectKnownValue("data.pf_kube_contexts.test", tfjsonpath.New("contexts").AtSliceIndex(1).AtMapKey("namespace"), knownvalue.StringExact("default")),
+					statecheck.ExpectKnownValue("data.pf_kube_contexts.test", tfjsonpath.New("contexts").AtSliceIndex(1).AtMapKey("exec"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"path/filepath"
+)
+
+/**************************************************************
+  Provider Definition
+ **************************************************************/
+
+var _ datasource.DataSourceWithConfigure = &kubeContextsDataSource{}
+
+func NewKubeContextsDataSource() datasource.DataSource {
+	return &kubeContextsDataSource{}
+}
+
+type kubeContextsDataSource struct {
+	panfactumDataSource
+}
+
+type kubeContextsDataSourceModel struct {
+	KubeConfigPath types.String       `tfsdk:"kubeconfig_path"`
+	Contexts       []kubeContextModel `tfsdk:"contexts"`
+}
+
+type kubeContextModel struct {
+	Name                     types.String          `tfsdk:"name"`
+	ClusterName              types.String          `tfsdk:"cluster_name"`
+	Server                   types.String          `tfsdk:"server"`
+	CertificateAuthority     types.String          `tfsdk:"certificate_authority"`
+	CertificateAuthorityData types.String          `tfsdk:"certificate_authority_data"`
+	Namespace                types.String          `tfsdk:"namespace"`
+	User                     types.String          `tfsdk:"user"`
+	Exec                     *kubeContextExecModel `tfsdk:"exec"`
+}
+
+func (d *kubeContextsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kube_contexts"
+}
+
+func (d *kubeContextsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	contextAttributes := kubeContextAttributes()
+	contextAttributes["name"] = schema.StringAttribute{
+		Description:         "The name of the context",
+		MarkdownDescription: "The name of the context",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Provides the cluster and user configuration of every context in a kubeconfig file",
+		MarkdownDescription: "Provides the cluster and user configuration of every context in a kubeconfig file",
+
+		Attributes: map[string]schema.Attribute{
+			"kubeconfig_path": schema.StringAttribute{
+				Description:         "The path to the kubeconfig file to read. Defaults to the kubeconfig file used by the provider.",
+				MarkdownDescription: "The path to the kubeconfig file to read. Defaults to the kubeconfig file used by the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"contexts": schema.ListNestedAttribute{
+				Description:         "The contexts in the kubeconfig file in the order that they are defined",
+				MarkdownDescription: "The contexts in the kubeconfig file in the order that they are defined",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: contextAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *kubeContextsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	// Guard against reads before the provider has been configured
+	if !d.checkProviderConfigured(req, resp) {
+		return
+	}
+
+	var data kubeContextsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Default to the kubeconfig file used by the provider
+	kubeConfigPath := d.ProviderData.KubeConfigPath
+	if !data.KubeConfigPath.IsNull() && !data.KubeConfigPath.IsUnknown() {
+		kubeConfigPath = filepath.Clean(data.KubeConfigPath.ValueString())
+	}
+	data.KubeConfigPath = types.StringValue(kubeConfigPath)
+
+	kubeContexts, err := getKubeContexts(kubeConfigPath)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("kubeconfig_path"), "Unable to load kubeconfig", fmt.Sprintf("%v", err))
+		return
+	}
+
+	data.Contexts = make([]kubeContextModel, 0, len(kubeContexts))
+	for _, kubeContext := range kubeContexts {
+		data.Contexts = append(data.Contexts, kubeContextModel{
+			Name:                     types.StringValue(kubeContext.Name),
+			ClusterName:              optionalStringValue(kubeContext.ClusterName),
+			Server:                   optionalStringValue(kubeContext.Server),
+			CertificateAuthority:     optionalStringValue(kubeContext.CertificateAuthority),
+			CertificateAuthorityData: optionalStringValue(kubeContext.CertificateAuthorityData),
+			Namespace:                optionalStringValue(kubeContext.Namespace),
+			User:                     optionalStringValue(kubeContext.User),
+			Exec:                     newKubeContextExecModel(kubeContext.Exec),
+		})
+	}
+
+	tflog.Debug(ctx, "Resolved kubeconfig contexts", map[string]any{
+		"kube_config_path": kubeConfigPath,
+		"contexts":         len(data.Contexts),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
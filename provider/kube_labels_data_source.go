@@ -9,21 +9,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 /**************************************************************
   Provider Definition
  **************************************************************/
 
-var _ datasource.DataSource = &kubeLabelsDataSource{}
+var _ datasource.DataSourceWithConfigure = &kubeLabelsDataSource{}
+
+// The provider attributes that the labels from getKubeLabels derive from
+var kubeLabelsProviderAttributes = []string{"environment", "region", "stack_version", "stack_commit", "root_module", "is_local", "extra_tags"}
 
 func NewKubeLabelsDataSource() datasource.DataSource {
 	return &kubeLabelsDataSource{}
 }
 
 type kubeLabelsDataSource struct {
-	ProviderData *PanfactumProvider
+	panfactumDataSource
 }
 
 type kubeLabelsDataSourceModel struct {
@@ -37,8 +43,8 @@ func (d *kubeLabelsDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 func (d *kubeLabelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "Provides the standard set of Panfactum resource labels for Kubernetes resources",
-		MarkdownDescription: "Provides the standard set of Panfactum resource labels for Kubernetes resources",
+		Description:         "Provides the standard set of Panfactum resource labels for Kubernetes resources." + unknownProviderConfigurationDescription,
+		MarkdownDescription: "Provides the standard set of Panfactum resource labels for Kubernetes resources." + unknownProviderConfigurationDescription,
 
 		Attributes: map[string]schema.Attribute{
 			"labels": schema.MapAttribute{
@@ -56,27 +62,13 @@ func (d *kubeLabelsDataSource) Schema(ctx context.Context, req datasource.Schema
 	}
 }
 
-func (d *kubeLabelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	data, ok := req.ProviderData.(*PanfactumProvider)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PanfactumProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (d *kubeLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
+	// Guard against reads before the provider has been configured
+	if !d.checkProviderConfigured(req, resp) {
 		return
 	}
 
-	d.ProviderData = data
-}
-
-func (d *kubeLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-
 	var data kubeLabelsDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -85,33 +77,24 @@ func (d *kubeLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	labels := map[string]attr.Value{
-		"panfactum.com/local": types.StringValue(d.ProviderData.IsLocal.String()),
+	// The labels derive from the provider configuration, so it must be known
+	if !d.checkProviderAttributesKnown(req, resp, kubeLabelsProviderAttributes...) {
+		return
 	}
 
-	// Set the default labels from the provider
-	setKubeLabel(labels, "panfactum.com/environment", d.ProviderData.Environment)
-	setKubeLabel(labels, "panfactum.com/region", d.ProviderData.Region)
-	setKubeLabel(labels, "panfactum.com/stack-version", d.ProviderData.StackVersion)
-	setKubeLabel(labels, "panfactum.com/stack-commit", d.ProviderData.StackCommit)
-	setKubeLabel(labels, "panfactum.com/root-module", d.ProviderData.RootModule)
-	setKubeLabel(labels, "panfactum.com/module", data.Module)
-
-	for key, value := range (d.ProviderData.ExtraTags).Elements() {
-		strValue, ok := value.(types.String)
-		if ok {
-			labels[sanitizeKubeLabelKey(key)] = sanitizeKubeLabelValueWrapped(strValue)
-		} else {
-			resp.Diagnostics.AddError(
-				"Invalid type found",
-				fmt.Sprintf("Failed to convert value for key '%s' to string.", key),
-			)
-			return
-		}
+	labels, diags := getKubeLabels(d.ProviderData, data.Module, path.Root("labels"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	data.Labels, _ = types.MapValue(types.StringType, labels)
 
+	tflog.Debug(ctx, "Computed Kubernetes labels", map[string]any{
+		"module": data.Module.ValueString(),
+		"labels": data.Labels.String(),
+	})
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -120,6 +103,40 @@ func (d *kubeLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequ
   Utility Functions
  **************************************************************/
 
+// getKubeLabels returns the standard set of Panfactum labels for resources in the module.
+// Diagnostics point at the given labels attribute of the calling data source.
+func getKubeLabels(providerData *PanfactumProvider, module types.String, labelsPath path.Path) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	labels := map[string]attr.Value{
+		"panfactum.com/local": types.StringValue(providerData.IsLocal.String()),
+	}
+
+	// Set the default labels from the provider
+	setKubeLabel(labels, "panfactum.com/environment", providerData.Environment)
+	setKubeLabel(labels, "panfactum.com/region", providerData.Region)
+	setKubeLabel(labels, "panfactum.com/stack-version", providerData.StackVersion)
+	setKubeLabel(labels, "panfactum.com/stack-commit", providerData.StackCommit)
+	setKubeLabel(labels, "panfactum.com/root-module", providerData.RootModule)
+	setKubeLabel(labels, "panfactum.com/module", module)
+
+	for key, value := range (providerData.ExtraTags).Elements() {
+		strValue, ok := value.(types.String)
+		if ok {
+			labels[sanitizeKubeLabelKey(key)] = sanitizeKubeLabelValueWrapped(strValue)
+		} else {
+			diags.AddAttributeError(
+				labelsPath.AtMapKey(sanitizeKubeLabelKey(key)),
+				"Invalid extra label",
+				fmt.Sprintf("The value of the provider's extra_tags entry '%s' must be a string.", key),
+			)
+			return nil, diags
+		}
+	}
+
+	return labels, diags
+}
+
 func setKubeLabel(tags map[string]attr.Value, key string, value types.String) {
 	if !value.IsNull() && !value.IsUnknown() {
 		tags[sanitizeKubeLabelKey(key)] = sanitizeKubeLabelValueWrapped(value)
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestKubeLabelsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                provider "pf" {
+                    environment   = "production"
+                    region        = "us-east-2"
+                    root_module   = "aws_eks"
+                    stack_version = "edge.24-10-01+local"
+                    stack_commit  = "0123456789abcdef"
+                    is_local      = true
+                    extra_tags    = {
+                        "example.com/owner" = "owner@example.com"
+                    }
+                }
+
+                data "pf_kube_labels" "test" {
+                    module = "vault"
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_kube_labels.test", tfjsonpath.New("labels"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"panfactum.com/local":         knownvalue.StringExact("true"),
+						"panfactum.com/environment":   knownvalue.StringExact("production"),
+						"panfactum.com/region":        knownvalue.StringExact("us-east-2"),
+						"panfactum.com/stack-version": knownvalue.StringExact("edge.24-10-01.local"),
+						"panfactum.com/stack-commit":  knownvalue.StringExact("0123456789abcdef"),
+						"panfactum.com/root-module":   knownvalue.StringExact("aws_eks"),
+						"panfactum.com/module":        knownvalue.StringExact("vault"),
+						"example.com/owner":           knownvalue.StringExact("owner.example.com"),
+					})),
+				},
+			},
+		},
+	})
+}
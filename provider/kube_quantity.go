@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// This file implements the Kubernetes resource.Quantity grammar
+// (https://github.com/kubernetes/apimachinery/blob/master/pkg/api/resource/quantity.go):
+//
+//	<quantity>        ::= <signedNumber><suffix>
+//	<suffix>          ::= <binarySI> | <decimalExponent> | <decimalSI>
+//	<binarySI>        ::= Ki | Mi | Gi | Ti | Pi | Ei
+//	<decimalSI>       ::= n | u | m | "" | k | M | G | T | P | E
+//	<decimalExponent> ::= "e" <signedNumber> | "E" <signedNumber>
+//
+// Values are represented exactly as rationals in base units (bytes or cores).
+
+type kubeQuantityFormat string
+
+const (
+	kubeQuantityBinarySI        kubeQuantityFormat = "binary_si"
+	kubeQuantityDecimalSI       kubeQuantityFormat = "decimal_si"
+	kubeQuantityDecimalExponent kubeQuantityFormat = "decimal_exponent"
+)
+
+var kubeQuantityFormats = []string{
+	string(kubeQuantityBinarySI),
+	string(kubeQuantityDecimalSI),
+	string(kubeQuantityDecimalExponent),
+}
+
+// kubeQuantityMaxExponent bounds decimal exponents so that malformed inputs
+// like 1e999999999 cannot exhaust memory
+const kubeQuantityMaxExponent = 1000
+
+var kubeQuantityBinarySuffixes = []struct {
+	suffix string
+	power  uint
+}{
+	{"Ei", 60}, {"Pi", 50}, {"Ti", 40}, {"Gi", 30}, {"Mi", 20}, {"Ki", 10},
+}
+
+var kubeQuantityDecimalSuffixes = []struct {
+	suffix   string
+	exponent int
+}{
+	{"E", 18}, {"P", 15}, {"T", 12}, {"G", 9}, {"M", 6}, {"k", 3}, {"", 0}, {"m", -3}, {"u", -6}, {"n", -9},
+}
+
+type kubeQuantity struct {
+	value  *big.Rat
+	format kubeQuantityFormat
+}
+
+// parseKubeQuantity parses the quantity string into its exact value in base units
+// and the format implied by its suffix
+func parseKubeQuantity(input string) (*kubeQuantity, error) {
+	if input == "" {
+		return nil, fmt.Errorf("quantity must not be empty")
+	}
+
+	number, suffix := splitKubeQuantity(input)
+	value, err := parseKubeQuantityNumber(number)
+	if err != nil {
+		return nil, fmt.Errorf("quantity '%s' is malformed: %v", input, err)
+	}
+
+	for _, s := range kubeQuantityBinarySuffixes {
+		if suffix == s.suffix {
+			return &kubeQuantity{
+				value:  value.Mul(value, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), s.power))),
+				format: kubeQuantityBinarySI,
+			}, nil
+		}
+	}
+
+	for _, s := range kubeQuantityDecimalSuffixes {
+		if suffix == s.suffix {
+			return &kubeQuantity{
+				value:  value.Mul(value, pow10Rat(s.exponent)),
+				format: kubeQuantityDecimalSI,
+			}, nil
+		}
+	}
+
+	if suffix[0] == 'e' || suffix[0] == 'E' {
+		exponent, err := parseKubeQuantityExponent(suffix[1:])
+		if err != nil {
+			return nil, fmt.Errorf("quantity '%s' has a malformed exponent: %v", input, err)
+		}
+		return &kubeQuantity{
+			value:  value.Mul(value, pow10Rat(exponent)),
+			format: kubeQuantityDecimalExponent,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("quantity '%s' has an unknown suffix '%s'", input, suffix)
+}
+
+// splitKubeQuantity splits the input into its leading signed number and its suffix
+func splitKubeQuantity(input string) (string, string) {
+	i := 0
+	if i < len(input) && (input[i] == '+' || input[i] == '-') {
+		i++
+	}
+	for i < len(input) && (input[i] >= '0' && input[i] <= '9' || input[i] == '.') {
+		i++
+	}
+	return input[:i], input[i:]
+}
+
+func parseKubeQuantityNumber(number string) (*big.Rat, error) {
+	negative := false
+	switch {
+	case strings.HasPrefix(number, "-"):
+		negative = true
+		number = number[1:]
+	case strings.HasPrefix(number, "+"):
+		number = number[1:]
+	}
+
+	whole, fraction, hasDot := strings.Cut(number, ".")
+	if whole == "" && fraction == "" {
+		return nil, fmt.Errorf("missing number")
+	}
+	if hasDot && strings.Contains(fraction, ".") {
+		return nil, fmt.Errorf("number has more than one decimal point")
+	}
+
+	digits, ok := new(big.Int).SetString(whole+fraction, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid number '%s'", number)
+	}
+	value := new(big.Rat).SetInt(digits)
+	value.Mul(value, pow10Rat(-len(fraction)))
+
+	if negative {
+		value.Neg(value)
+	}
+	return value, nil
+}
+
+func parseKubeQuantityExponent(exponent string) (int, error) {
+	sign := 1
+	switch {
+	case strings.HasPrefix(exponent, "-"):
+		sign = -1
+		exponent = exponent[1:]
+	case strings.HasPrefix(exponent, "+"):
+		exponent = exponent[1:]
+	}
+
+	if exponent == "" {
+		return 0, fmt.Errorf("missing exponent")
+	}
+
+	value := 0
+	for _, c := range exponent {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid exponent '%s'", exponent)
+		}
+		value = value*10 + int(c-'0')
+		if value > kubeQuantityMaxExponent {
+			return 0, fmt.Errorf("exponent must be at most %d", kubeQuantityMaxExponent)
+		}
+	}
+	return sign * value, nil
+}
+
+func pow10Rat(exponent int) *big.Rat {
+	abs := exponent
+	if abs < 0 {
+		abs = -abs
+	}
+	power := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs)), nil)
+	if exponent < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), power)
+	}
+	return new(big.Rat).SetInt(power)
+}
+
+// formatKubeQuantity renders the value in its canonical Kubernetes form for the given format.
+// Like Kubernetes, values with more precision than 1n are rounded up (away from zero) to the
+// nearest 1n, and the binary SI format falls back to decimal SI for values that are not whole
+// numbers.
+func formatKubeQuantity(value *big.Rat, format kubeQuantityFormat) (string, error) {
+	nanos := roundUpKubeQuantity(value)
+
+	switch format {
+	case kubeQuantityBinarySI:
+		if nanos.IsInt() {
+			return formatKubeQuantityBinarySI(nanos.Num()), nil
+		}
+		return formatKubeQuantityDecimal(nanos, false), nil
+	case kubeQuantityDecimalSI:
+		return formatKubeQuantityDecimal(nanos, false), nil
+	case kubeQuantityDecimalExponent:
+		return formatKubeQuantityDecimal(nanos, true), nil
+	default:
+		return "", fmt.Errorf("unsupported format '%s'; must be one of: %s", format, strings.Join(kubeQuantityFormats, ", "))
+	}
+}
+
+// roundUpKubeQuantity rounds the value away from zero to the nearest multiple of 1n
+func roundUpKubeQuantity(value *big.Rat) *big.Rat {
+	scaled := new(big.Rat).Mul(value, pow10Rat(9))
+	quotient, remainder := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if remainder.Sign() != 0 {
+		quotient.Add(quotient, big.NewInt(int64(remainder.Sign())))
+	}
+	return new(big.Rat).SetFrac(quotient, new(big.Int).Exp(big.NewInt(10), big.NewInt(9), nil))
+}
+
+func formatKubeQuantityBinarySI(value *big.Int) string {
+	if value.Sign() != 0 {
+		for _, s := range kubeQuantityBinarySuffixes {
+			divisor := new(big.Int).Lsh(big.NewInt(1), s.power)
+			if quotient, remainder := new(big.Int).QuoRem(value, divisor, new(big.Int)); remainder.Sign() == 0 {
+				return quotient.String() + s.suffix
+			}
+		}
+	}
+	return value.String()
+}
+
+// formatKubeQuantityDecimal renders the value using the largest power of 1000 that keeps
+// the mantissa a whole number. The value must already be rounded to a multiple of 1n.
+func formatKubeQuantityDecimal(value *big.Rat, exponentForm bool) string {
+	if value.Sign() == 0 {
+		return "0"
+	}
+
+	for _, s := range kubeQuantityDecimalSuffixes {
+		mantissa := new(big.Rat).Mul(value, pow10Rat(-s.exponent))
+		if !mantissa.IsInt() {
+			continue
+		}
+
+		if !exponentForm {
+			return mantissa.Num().String() + s.suffix
+		}
+		if s.exponent == 0 {
+			return mantissa.Num().String()
+		}
+		return fmt.Sprintf("%se%d", mantissa.Num().String(), s.exponent)
+	}
+
+	// Unreachable for values rounded to a multiple of 1n
+	return value.FloatString(9)
+}
+
+func (q *kubeQuantity) String() string {
+	formatted, _ := formatKubeQuantity(q.value, q.format)
+	return formatted
+}
+
+// bigFloatToRat converts a Terraform number into a rational. Terraform numbers are
+// binary floating point values parsed from decimal strings, so they are rounded to 40
+// significant decimal digits first to recover the decimal value that was written
+// (e.g., 0.1 rather than 0.1000000000000000000000000000000000000000000000000000001).
+func bigFloatToRat(value *big.Float) (*big.Rat, bool) {
+	if value.IsInf() {
+		return nil, false
+	}
+	return new(big.Rat).SetString(value.Text('g', 40))
+}
+
+// kubeQuantityToBigFloat converts the exact value into a Terraform number
+func kubeQuantityToBigFloat(value *big.Rat) *big.Float {
+	return new(big.Float).SetPrec(512).SetRat(value)
+}
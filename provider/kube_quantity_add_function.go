@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"math/big"
+)
+
+var (
+	_ function.Function = KubeQuantityAddFunction{}
+)
+
+func NewKubeQuantityAddFunction() function.Function {
+	return KubeQuantityAddFunction{}
+}
+
+type KubeQuantityAddFunction struct{}
+
+func (f KubeQuantityAddFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kube_quantity_add"
+}
+
+func (f KubeQuantityAddFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the sum of two Kubernetes resource quantities.",
+		Description: "The result is rendered in the canonical form of the unit style of the first quantity.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The first Kubernetes resource quantity",
+				Name:               "a",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The second Kubernetes resource quantity",
+				Name:               "b",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f KubeQuantityAddFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var aStr, bStr string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &aStr, &bStr))
+	if resp.Error != nil {
+		return
+
+	}
+
+	a, err := parseKubeQuantity(aStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	b, err := parseKubeQuantity(bStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	sum := kubeQuantity{value: new(big.Rat).Add(a.value, b.value), format: a.format}
+
+	result := sum.String()
+	tflog.Debug(ctx, "Added Kubernetes quantities", map[string]any{"a": aStr, "b": bStr, "sum": result})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
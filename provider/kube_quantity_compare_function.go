@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = KubeQuantityCompareFunction{}
+)
+
+func NewKubeQuantityCompareFunction() function.Function {
+	return KubeQuantityCompareFunction{}
+}
+
+type KubeQuantityCompareFunction struct{}
+
+func (f KubeQuantityCompareFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kube_quantity_compare"
+}
+
+func (f KubeQuantityCompareFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns -1, 0, or 1 if the first Kubernetes resource quantity is less than, equal to, or greater than the second.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The first Kubernetes resource quantity",
+				Name:               "a",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The second Kubernetes resource quantity",
+				Name:               "b",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f KubeQuantityCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var aStr, bStr string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &aStr, &bStr))
+	if resp.Error != nil {
+		return
+
+	}
+
+	a, err := parseKubeQuantity(aStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	b, err := parseKubeQuantity(bStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	result := int64(a.value.Cmp(b.value))
+	tflog.Debug(ctx, "Compared Kubernetes quantities", map[string]any{"a": aStr, "b": bStr, "result": result})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
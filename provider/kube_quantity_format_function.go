@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"math/big"
+)
+
+var (
+	_ function.Function = KubeQuantityFormatFunction{}
+)
+
+func NewKubeQuantityFormatFunction() function.Function {
+	return KubeQuantityFormatFunction{}
+}
+
+type KubeQuantityFormatFunction struct{}
+
+func (f KubeQuantityFormatFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kube_quantity_format"
+}
+
+func (f KubeQuantityFormatFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the canonical Kubernetes resource quantity for a value in base units (e.g., bytes or cores).",
+		Description: "The unit style must be one of binary_si (e.g., 1536Mi), decimal_si (e.g., 500m), or decimal_exponent (e.g., 2e3). Like Kubernetes, values more precise than 1n are rounded up, and binary_si falls back to decimal_si for fractional values.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The value in base units to format",
+				Name:               "value",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The style of the unit suffix: binary_si, decimal_si, or decimal_exponent",
+				Name:               "unit_style",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f KubeQuantityFormatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value *big.Float
+	var unitStyle string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value, &unitStyle))
+	if resp.Error != nil {
+		return
+
+	}
+
+	rat, ok := bigFloatToRat(value)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "Value must be a finite number\n"))
+		return
+	}
+
+	formatted, err := formatKubeQuantity(rat, kubeQuantityFormat(unitStyle))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	tflog.Debug(ctx, "Formatted Kubernetes quantity", map[string]any{"style": unitStyle, "quantity": formatted})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, formatted))
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"math/big"
+)
+
+var (
+	_ function.Function = KubeQuantityMultiplyFunction{}
+)
+
+func NewKubeQuantityMultiplyFunction() function.Function {
+	return KubeQuantityMultiplyFunction{}
+}
+
+type KubeQuantityMultiplyFunction struct{}
+
+func (f KubeQuantityMultiplyFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kube_quantity_multiply"
+}
+
+func (f KubeQuantityMultiplyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a Kubernetes resource quantity multiplied by a factor.",
+		Description: "The result is rendered in the canonical form of the unit style of the quantity. Like Kubernetes, results more precise than 1n are rounded up.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The Kubernetes resource quantity to multiply",
+				Name:               "quantity",
+			},
+			function.NumberParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The factor to multiply the quantity by",
+				Name:               "factor",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f KubeQuantityMultiplyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var factor *big.Float
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &factor))
+	if resp.Error != nil {
+		return
+
+	}
+
+	quantity, err := parseKubeQuantity(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	factorRat, ok := bigFloatToRat(factor)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "Factor must be a finite number\n"))
+		return
+	}
+
+	product := kubeQuantity{value: new(big.Rat).Mul(quantity.value, factorRat), format: quantity.format}
+
+	result := product.String()
+	tflog.Debug(ctx, "Multiplied Kubernetes quantity", map[string]any{"quantity": input, "product": result})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
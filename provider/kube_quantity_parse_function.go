@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = KubeQuantityParseFunction{}
+)
+
+func NewKubeQuantityParseFunction() function.Function {
+	return KubeQuantityParseFunction{}
+}
+
+type KubeQuantityParseFunction struct{}
+
+func (f KubeQuantityParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kube_quantity_parse"
+}
+
+func (f KubeQuantityParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the value of a Kubernetes resource quantity in base units (e.g., bytes or cores).",
+		Description: "Parses quantities using the exact Kubernetes resource.Quantity grammar, including binary SI (1.5Gi), decimal SI (500m), and decimal exponent (2e3) suffixes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The Kubernetes resource quantity to parse",
+				Name:               "quantity",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f KubeQuantityParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+
+	}
+
+	quantity, err := parseKubeQuantity(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := kubeQuantityToBigFloat(quantity.value)
+	tflog.Debug(ctx, "Parsed Kubernetes quantity", map[string]any{"quantity": input, "value": quantity.value.RatString()})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseKubeQuantity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+		format   kubeQuantityFormat
+	}{
+		{"500m", "1/2", kubeQuantityDecimalSI},
+		{"1.5Gi", "1610612736", kubeQuantityBinarySI},
+		{"2e3", "2000", kubeQuantityDecimalExponent},
+		{"2E-3", "1/500", kubeQuantityDecimalExponent},
+		{"1E", "1000000000000000000", kubeQuantityDecimalSI},
+		{"100", "100", kubeQuantityDecimalSI},
+		{".5", "1/2", kubeQuantityDecimalSI},
+		{"5.", "5", kubeQuantityDecimalSI},
+		{"-1k", "-1000", kubeQuantityDecimalSI},
+		{"+1Ki", "1024", kubeQuantityBinarySI},
+		{"1n", "1/1000000000", kubeQuantityDecimalSI},
+		{"0.1u", "1/10000000", kubeQuantityDecimalSI},
+	}
+
+	for _, test := range tests {
+		quantity, err := parseKubeQuantity(test.input)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.input, err)
+			continue
+		}
+		if quantity.value.RatString() != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.input, test.expected, quantity.value.RatString())
+		}
+		if quantity.format != test.format {
+			t.Errorf("%s: expected format %s, got %s", test.input, test.format, quantity.format)
+		}
+	}
+}
+
+func TestParseKubeQuantity_Malformed(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "Gi", "1.2.3", "1 Gi", "1gi", "1KiB", "1e", "1e+", "1e1.5", "1e99999", ".", "-", "1Ki5", "--1"} {
+		if _, err := parseKubeQuantity(input); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestFormatKubeQuantity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		format   kubeQuantityFormat
+		expected string
+	}{
+		{"1610612736", kubeQuantityBinarySI, "1536Mi"},
+		{"1024", kubeQuantityBinarySI, "1Ki"},
+		{"1000", kubeQuantityBinarySI, "1000"},
+		{"1/2", kubeQuantityBinarySI, "500m"},
+		{"1/2", kubeQuantityDecimalSI, "500m"},
+		{"1500", kubeQuantityDecimalSI, "1500"},
+		{"2000", kubeQuantityDecimalSI, "2k"},
+		{"0", kubeQuantityDecimalSI, "0"},
+		{"-3000000", kubeQuantityDecimalSI, "-3M"},
+		{"2000", kubeQuantityDecimalExponent, "2e3"},
+		{"1/500", kubeQuantityDecimalExponent, "2e-3"},
+		{"7", kubeQuantityDecimalExponent, "7"},
+		{"1/3", kubeQuantityDecimalSI, "333333334n"},
+		{"-1/3", kubeQuantityDecimalSI, "-333333334n"},
+	}
+
+	for _, test := range tests {
+		value, _ := new(big.Rat).SetString(test.value)
+		actual, err := formatKubeQuantity(value, test.format)
+		if err != nil {
+			t.Errorf("%s %s: unexpected error: %v", test.value, test.format, err)
+		} else if actual != test.expected {
+			t.Errorf("%s %s: expected %s, got %s", test.value, test.format, test.expected, actual)
+		}
+	}
+
+	if _, err := formatKubeQuantity(big.NewRat(1, 1), "binary"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
+
+func TestKubeQuantity_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"1536Mi", "500m", "2e3", "1Ki", "100", "3n"} {
+		quantity, err := parseKubeQuantity(input)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", input, err)
+		}
+		if quantity.String() != input {
+			t.Errorf("%s: expected canonical round trip, got %s", input, quantity.String())
+		}
+	}
+}
+
+func TestBigFloatToRat(t *testing.T) {
+	t.Parallel()
+
+	value, _, _ := big.ParseFloat("0.1", 10, 512, big.ToNearestEven)
+	rat, ok := bigFloatToRat(value)
+	if !ok || rat.RatString() != "1/10" {
+		t.Errorf("expected 1/10, got %s", rat.RatString())
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/**************************************************************
+  Provider Definition
+ **************************************************************/
+
+var _ datasource.DataSourceWithConfigure = &kubeSchedulingDataSource{}
+
+// The provider attributes that the default match labels derive from. The stack version and commit
+// are left out, as pod selectors are immutable for some workloads and must not change on every stack upgrade.
+var kubeSchedulingMatchLabelsProviderAttributes = []string{"environment", "region", "root_module", "is_local", "extra_tags"}
+
+// The standard labels that are left out of the default match labels
+var kubeSchedulingExcludedMatchLabels = []string{"panfactum.com/stack-version", "panfactum.com/stack-commit"}
+
+func NewKubeSchedulingDataSource() datasource.DataSource {
+	return &kubeSchedulingDataSource{}
+}
+
+type kubeSchedulingDataSource struct {
+	panfactumDataSource
+}
+
+type kubeSchedulingDataSourceModel struct {
+	Module                    types.String                        `tfsdk:"module"`
+	MatchLabels               types.Map                           `tfsdk:"match_labels"`
+	SLATargetOverride         types.Int32                         `tfsdk:"sla_target_override"`
+	SLATarget                 types.Int32                         `tfsdk:"sla_target"`
+	Replicas                  types.Int32                         `tfsdk:"replicas"`
+	TopologySpreadConstraints []kubeTopologySpreadConstraintModel `tfsdk:"topology_spread_constraints"`
+	Affinity                  *kubeAffinityModel                  `tfsdk:"affinity"`
+	PodDisruptionBudget       *kubePodDisruptionBudgetModel       `tfsdk:"pod_disruption_budget"`
+}
+
+type kubeLabelSelectorModel struct {
+	MatchLabels types.Map `tfsdk:"match_labels"`
+}
+
+type kubeTopologySpreadConstraintModel struct {
+	MaxSkew           types.Int32            `tfsdk:"max_skew"`
+	TopologyKey       types.String           `tfsdk:"topology_key"`
+	WhenUnsatisfiable types.String           `tfsdk:"when_unsatisfiable"`
+	LabelSelector     kubeLabelSelectorModel `tfsdk:"label_selector"`
+}
+
+type kubePodAffinityTermModel struct {
+	TopologyKey   types.String           `tfsdk:"topology_key"`
+	LabelSelector kubeLabelSelectorModel `tfsdk:"label_selector"`
+}
+
+type kubeWeightedPodAffinityTermModel struct {
+	Weight          types.Int32              `tfsdk:"weight"`
+	PodAffinityTerm kubePodAffinityTermModel `tfsdk:"pod_affinity_term"`
+}
+
+type kubePodAntiAffinityModel struct {
+	Required  []kubePodAffinityTermModel         `tfsdk:"required_during_scheduling_ignored_during_execution"`
+	Preferred []kubeWeightedPodAffinityTermModel `tfsdk:"preferred_during_scheduling_ignored_during_execution"`
+}
+
+type kubeAffinityModel struct {
+	PodAntiAffinity kubePodAntiAffinityModel `tfsdk:"pod_anti_affinity"`
+}
+
+type kubePodDisruptionBudgetModel struct {
+	MinAvailable               types.Int32  `tfsdk:"min_available"`
+	UnhealthyPodEvictionPolicy types.String `tfsdk:"unhealthy_pod_eviction_policy"`
+}
+
+const (
+	kubeZoneTopologyKey = "topology.kubernetes.io/zone"
+	kubeHostTopologyKey = "kubernetes.io/hostname"
+)
+
+func (d *kubeSchedulingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kube_scheduling"
+}
+
+func (d *kubeSchedulingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	podAffinityTermAttributes := map[string]schema.Attribute{
+		"topology_key": schema.StringAttribute{
+			Description:         "The node label that defines the topology domain",
+			MarkdownDescription: "The node label that defines the topology domain",
+			Computed:            true,
+		},
+		"label_selector": kubeLabelSelectorAttribute(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Provides the recommended Kubernetes scheduling settings for a workload based on the Panfactum SLA target." + unknownProviderConfigurationDescription,
+		MarkdownDescription: "Provides the recommended Kubernetes scheduling settings for a workload based on the Panfactum SLA target." + unknownProviderConfigurationDescription,
+
+		Attributes: map[string]schema.Attribute{
+			"module": schema.StringAttribute{
+				Description:         "The module within which this data source is called",
+				MarkdownDescription: "The module within which this data source is called",
+				Required:            true,
+			},
+			"match_labels": schema.MapAttribute{
+				Description:         "The labels that select the pods of the workload. Defaults to the labels from pf_kube_labels for the module, except for the stack version and commit labels.",
+				MarkdownDescription: "The labels that select the pods of the workload. Defaults to the labels from `pf_kube_labels` for the module, except for the `panfactum.com/stack-version` and `panfactum.com/stack-commit` labels.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"sla_target_override": schema.Int32Attribute{
+				Description:         "Overrides the SLA target of the provider",
+				MarkdownDescription: "Overrides the SLA target of the provider",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AtMost(3),
+				},
+			},
+			"sla_target": schema.Int32Attribute{
+				Description:         "The SLA target that the settings were derived from",
+				MarkdownDescription: "The SLA target that the settings were derived from",
+				Computed:            true,
+			},
+			"replicas": schema.Int32Attribute{
+				Description:         "The minimum number of replicas that the workload should run",
+				MarkdownDescription: "The minimum number of replicas that the workload should run",
+				Computed:            true,
+			},
+			"topology_spread_constraints": schema.ListNestedAttribute{
+				Description:         "The topologySpreadConstraints for the pods of the workload",
+				MarkdownDescription: "The `topologySpreadConstraints` for the pods of the workload",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"max_skew": schema.Int32Attribute{
+							Description:         "The maximum permitted difference in the number of pods between topology domains",
+							MarkdownDescription: "The maximum permitted difference in the number of pods between topology domains",
+							Computed:            true,
+						},
+						"topology_key": schema.StringAttribute{
+							Description:         "The node label that defines the topology domain",
+							MarkdownDescription: "The node label that defines the topology domain",
+							Computed:            true,
+						},
+						"when_unsatisfiable": schema.StringAttribute{
+							Description:         "How to handle pods that do not satisfy the constraint (DoNotSchedule or ScheduleAnyway)",
+							MarkdownDescription: "How to handle pods that do not satisfy the constraint (`DoNotSchedule` or `ScheduleAnyway`)",
+							Computed:            true,
+						},
+						"label_selector": kubeLabelSelectorAttribute(),
+					},
+				},
+			},
+			"affinity": schema.SingleNestedAttribute{
+				Description:         "The affinity settings for the pods of the workload",
+				MarkdownDescription: "The `affinity` settings for the pods of the workload",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"pod_anti_affinity": schema.SingleNestedAttribute{
+						Description:         "The pod anti-affinity rules that keep replicas on separate nodes",
+						MarkdownDescription: "The pod anti-affinity rules that keep replicas on separate nodes",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"required_during_scheduling_ignored_during_execution": schema.ListNestedAttribute{
+								Description:         "Anti-affinity terms that must be satisfied for a pod to be scheduled",
+								MarkdownDescription: "Anti-affinity terms that must be satisfied for a pod to be scheduled",
+								Computed:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: podAffinityTermAttributes,
+								},
+							},
+							"preferred_during_scheduling_ignored_during_execution": schema.ListNestedAttribute{
+								Description:         "Anti-affinity terms that the scheduler will try to satisfy",
+								MarkdownDescription: "Anti-affinity terms that the scheduler will try to satisfy",
+								Computed:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"weight": schema.Int32Attribute{
+											Description:         "The weight of the term in the range 1-100",
+											MarkdownDescription: "The weight of the term in the range 1-100",
+											Computed:            true,
+										},
+										"pod_affinity_term": schema.SingleNestedAttribute{
+											Description:         "The anti-affinity term",
+											MarkdownDescription: "The anti-affinity term",
+											Computed:            true,
+											Attributes:          podAffinityTermAttributes,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"pod_disruption_budget": schema.SingleNestedAttribute{
+				Description:         "The PodDisruptionBudget settings for the workload",
+				MarkdownDescription: "The PodDisruptionBudget settings for the workload",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"min_available": schema.Int32Attribute{
+						Description:         "The minimum number of pods that must remain available during voluntary disruptions",
+						MarkdownDescription: "The minimum number of pods that must remain available during voluntary disruptions",
+						Computed:            true,
+					},
+					"unhealthy_pod_eviction_policy": schema.StringAttribute{
+						Description:         "The policy for evicting unhealthy pods",
+						MarkdownDescription: "The policy for evicting unhealthy pods",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *kubeSchedulingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	// Guard against reads before the provider has been configured
+	if !d.checkProviderConfigured(req, resp) {
+		return
+	}
+
+	var data kubeSchedulingDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default match labels and SLA target derive from the provider configuration, so it must be known
+	var providerAttributes []string
+	if data.MatchLabels.IsNull() {
+		providerAttributes = append(providerAttributes, kubeSchedulingMatchLabelsProviderAttributes...)
+	}
+	if data.SLATargetOverride.IsNull() {
+		providerAttributes = append(providerAttributes, "sla_target")
+	}
+	if !d.checkProviderAttributesKnown(req, resp, providerAttributes...) {
+		return
+	}
+
+	// Default to selecting the pods by the standard labels of the module
+	if data.MatchLabels.IsNull() {
+		labels, diags := getKubeLabels(d.ProviderData, data.Module, path.Root("match_labels"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, key := range kubeSchedulingExcludedMatchLabels {
+			delete(labels, key)
+		}
+		data.MatchLabels, _ = types.MapValue(types.StringType, labels)
+	}
+
+	// Allow the SLA target to be overridden
+	var slaTarget = d.ProviderData.SLATarget
+	if !data.SLATargetOverride.IsNull() && !data.SLATargetOverride.IsUnknown() {
+		slaTarget = data.SLATargetOverride
+	}
+
+	profile, err := getSLAProfile(slaTarget.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sla_target_override"), "Invalid SLA target", fmt.Sprintf("%v", err))
+		return
+	}
+
+	selector := kubeLabelSelectorModel{MatchLabels: data.MatchLabels}
+
+	data.SLATarget = slaTarget
+	data.Replicas = types.Int32Value(profile.MinReplicas)
+	data.TopologySpreadConstraints = []kubeTopologySpreadConstraintModel{
+		{
+			MaxSkew:           types.Int32Value(1),
+			TopologyKey:       types.StringValue(kubeZoneTopologyKey),
+			WhenUnsatisfiable: types.StringValue(profile.TopologySpreadWhenUnsatisfiable),
+			LabelSelector:     selector,
+		},
+		{
+			MaxSkew:           types.Int32Value(1),
+			TopologyKey:       types.StringValue(kubeHostTopologyKey),
+			WhenUnsatisfiable: types.StringValue("ScheduleAnyway"),
+			LabelSelector:     selector,
+		},
+	}
+
+	hostAntiAffinityTerm := kubePodAffinityTermModel{
+		TopologyKey:   types.StringValue(kubeHostTopologyKey),
+		LabelSelector: selector,
+	}
+	data.Affinity = &kubeAffinityModel{
+		PodAntiAffinity: kubePodAntiAffinityModel{
+			Required:  []kubePodAffinityTermModel{},
+			Preferred: []kubeWeightedPodAffinityTermModel{},
+		},
+	}
+	if profile.HostAntiAffinityRequired {
+		data.Affinity.PodAntiAffinity.Required = append(data.Affinity.PodAntiAffinity.Required, hostAntiAffinityTerm)
+	} else {
+		data.Affinity.PodAntiAffinity.Preferred = append(data.Affinity.PodAntiAffinity.Preferred, kubeWeightedPodAffinityTermModel{
+			Weight:          types.Int32Value(100),
+			PodAffinityTerm: hostAntiAffinityTerm,
+		})
+	}
+
+	data.PodDisruptionBudget = &kubePodDisruptionBudgetModel{
+		MinAvailable:               types.Int32Value(profile.PDBMinAvailable),
+		UnhealthyPodEvictionPolicy: types.StringValue("AlwaysAllow"),
+	}
+
+	tflog.Debug(ctx, "Computed Kubernetes scheduling settings", map[string]any{
+		"module":                      data.Module.ValueString(),
+		"sla_target":                  slaTarget.ValueInt32(),
+		"replicas":                    profile.MinReplicas,
+		"host_anti_affinity_required": profile.HostAntiAffinityRequired,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+/**************************************************************
+  Utility Functions
+ **************************************************************/
+
+func kubeLabelSelectorAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description:         "The label selector that selects the pods of the workload",
+		MarkdownDescription: "The label selector that selects the pods of the workload",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"match_labels": schema.MapAttribute{
+				Description:         "The labels that the pods must have",
+				MarkdownDescription: "The labels that the pods must have",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestKubeSchedulingDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                provider "pf" {
+                    environment = "production"
+                }
+
+                data "pf_kube_scheduling" "default" {
+                    module = "vault"
+                }
+
+                data "pf_kube_scheduling" "override" {
+                    module              = "vault"
+                    match_labels        = { app = "vault" }
+                    sla_target_override = 1
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.default", tfjsonpath.New("replicas"), knownvalue.Int32Exact(3)),
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.default", tfjsonpath.New("match_labels").AtMapKey("panfactum.com/module"), knownvalue.StringExact("vault")),
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.default", tfjsonpath.New("topology_spread_constraints").AtSliceIndex(0).AtMapKey("when_unsatisfiable"), knownvalue.StringExact("DoNotSchedule")),
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.default", tfjsonpath.New("affinity").AtMapKey("pod_anti_affinity").AtMapKey("required_during_scheduling_ignored_during_execution"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.default", tfjsonpath.New("pod_disruption_budget").AtMapKey("min_available"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.override", tfjsonpath.New("replicas"), knownvalue.Int32Exact(1)),
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.override", tfjsonpath.New("topology_spread_constraints").AtSliceIndex(0).AtMapKey("label_selector").AtMapKey("match_labels"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"app": knownvalue.StringExact("vault"),
+					})),
+					statecheck.ExpectKnownValue("data.pf_kube_scheduling.override", tfjsonpath.New("affinity").AtMapKey("pod_anti_affinity").AtMapKey("required_during_scheduling_ignored_during_execution"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,420 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// This file implements Kubernetes label selectors
+// (https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors)
+// with the same semantics as k8s.io/apimachinery/pkg/labels.
+
+const (
+	kubeSelectorOpIn           = "In"
+	kubeSelectorOpNotIn        = "NotIn"
+	kubeSelectorOpExists       = "Exists"
+	kubeSelectorOpDoesNotExist = "DoesNotExist"
+)
+
+type kubeSelector struct {
+	MatchLabels      map[string]string         `tfsdk:"match_labels"`
+	MatchExpressions []kubeSelectorRequirement `tfsdk:"match_expressions"`
+}
+
+type kubeSelectorRequirement struct {
+	Key      string   `tfsdk:"key"`
+	Operator string   `tfsdk:"operator"`
+	Values   []string `tfsdk:"values"`
+}
+
+var kubeSelectorAttrTypes = map[string]attr.Type{
+	"match_labels": types.MapType{ElemType: types.StringType},
+	"match_expressions": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"key":      types.StringType,
+		"operator": types.StringType,
+		"values":   types.ListType{ElemType: types.StringType},
+	}}},
+}
+
+var (
+	kubeSelectorKeyRegex     = regexp.MustCompile(`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	kubeSelectorValueRegex   = regexp.MustCompile(`^([A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?)?$`)
+	kubeSelectorSetExprRegex = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+// matches returns true if the labels satisfy every requirement of the selector.
+// An empty selector matches all labels.
+func (s *kubeSelector) matches(labels map[string]string) bool {
+	for key, value := range s.MatchLabels {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	for _, requirement := range s.MatchExpressions {
+		if !requirement.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *kubeSelectorRequirement) matches(labels map[string]string) bool {
+	value, exists := labels[r.Key]
+	switch r.Operator {
+	case kubeSelectorOpIn:
+		return exists && containsString(r.Values, value)
+	case kubeSelectorOpNotIn:
+		return !exists || !containsString(r.Values, value)
+	case kubeSelectorOpExists:
+		return exists
+	case kubeSelectorOpDoesNotExist:
+		return !exists
+	default:
+		return false
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// validate checks that the selector is well-formed according to the Kubernetes API
+func (s *kubeSelector) validate() error {
+	for key, value := range s.MatchLabels {
+		if err := validateKubeSelectorKey(key); err != nil {
+			return err
+		}
+		if !kubeSelectorValueRegex.MatchString(value) || len(value) > 63 {
+			return fmt.Errorf("invalid label value '%s' for key '%s'", value, key)
+		}
+	}
+
+	for _, requirement := range s.MatchExpressions {
+		if err := validateKubeSelectorKey(requirement.Key); err != nil {
+			return err
+		}
+		switch requirement.Operator {
+		case kubeSelectorOpIn, kubeSelectorOpNotIn:
+			if len(requirement.Values) == 0 {
+				return fmt.Errorf("values must be non-empty for operator %s on key '%s'", requirement.Operator, requirement.Key)
+			}
+		case kubeSelectorOpExists, kubeSelectorOpDoesNotExist:
+			if len(requirement.Values) != 0 {
+				return fmt.Errorf("values must be empty for operator %s on key '%s'", requirement.Operator, requirement.Key)
+			}
+		default:
+			return fmt.Errorf("unsupported operator '%s' on key '%s'; must be one of: In, NotIn, Exists, DoesNotExist", requirement.Operator, requirement.Key)
+		}
+		for _, value := range requirement.Values {
+			if !kubeSelectorValueRegex.MatchString(value) || len(value) > 63 {
+				return fmt.Errorf("invalid label value '%s' for key '%s'", value, requirement.Key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateKubeSelectorKey(key string) error {
+	prefix, name, hasPrefix := strings.Cut(key, "/")
+	if !hasPrefix {
+		name = prefix
+		prefix = ""
+	}
+	if !kubeSelectorKeyRegex.MatchString(key) || len(name) > 63 || len(prefix) > 253 {
+		return fmt.Errorf("invalid label key '%s'", key)
+	}
+	return nil
+}
+
+// parseKubeSelector parses the string selector syntax used by kubectl (e.g., 'a=b,c in (d,e),!f')
+func parseKubeSelector(input string) (*kubeSelector, error) {
+	selector := kubeSelector{MatchLabels: map[string]string{}, MatchExpressions: []kubeSelectorRequirement{}}
+
+	requirements, err := splitKubeSelector(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, requirement := range requirements {
+		switch {
+		case strings.HasPrefix(requirement, "!"):
+			selector.MatchExpressions = append(selector.MatchExpressions, kubeSelectorRequirement{
+				Key:      strings.TrimSpace(requirement[1:]),
+				Operator: kubeSelectorOpDoesNotExist,
+				Values:   []string{},
+			})
+		case kubeSelectorSetExprRegex.MatchString(requirement):
+			match := kubeSelectorSetExprRegex.FindStringSubmatch(requirement)
+			operator := kubeSelectorOpIn
+			if match[2] == "notin" {
+				operator = kubeSelectorOpNotIn
+			}
+			values := []string{}
+			for _, value := range strings.Split(match[3], ",") {
+				values = append(values, strings.TrimSpace(value))
+			}
+			selector.MatchExpressions = append(selector.MatchExpressions, kubeSelectorRequirement{
+				Key:      match[1],
+				Operator: operator,
+				Values:   values,
+			})
+		case strings.Contains(requirement, "!="):
+			key, value, _ := strings.Cut(requirement, "!=")
+			selector.MatchExpressions = append(selector.MatchExpressions, kubeSelectorRequirement{
+				Key:      strings.TrimSpace(key),
+				Operator: kubeSelectorOpNotIn,
+				Values:   []string{strings.TrimSpace(value)},
+			})
+		case strings.Contains(requirement, "="):
+			key, value, _ := strings.Cut(requirement, "=")
+			key = strings.TrimSpace(key)
+			value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+
+			// Repeated equality requirements on the same key cannot be represented in matchLabels
+			if _, exists := selector.MatchLabels[key]; exists {
+				selector.MatchExpressions = append(selector.MatchExpressions, kubeSelectorRequirement{
+					Key:      key,
+					Operator: kubeSelectorOpIn,
+					Values:   []string{value},
+				})
+			} else {
+				selector.MatchLabels[key] = value
+			}
+		default:
+			selector.MatchExpressions = append(selector.MatchExpressions, kubeSelectorRequirement{
+				Key:      requirement,
+				Operator: kubeSelectorOpExists,
+				Values:   []string{},
+			})
+		}
+	}
+
+	if err := selector.validate(); err != nil {
+		return nil, fmt.Errorf("selector '%s' is invalid: %v", input, err)
+	}
+
+	return &selector, nil
+}
+
+// splitKubeSelector splits the selector on commas that are not inside parentheses
+func splitKubeSelector(input string) ([]string, error) {
+	var requirements []string
+	depth := 0
+	start := 0
+
+	for i, c := range input {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("selector '%s' has nested parentheses", input)
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("selector '%s' has unbalanced parentheses", input)
+			}
+		case ',':
+			if depth == 0 {
+				requirements = append(requirements, input[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("selector '%s' has unbalanced parentheses", input)
+	}
+	requirements = append(requirements, input[start:])
+
+	// An empty selector string selects everything
+	if len(requirements) == 1 && strings.TrimSpace(requirements[0]) == "" {
+		return nil, nil
+	}
+
+	for i, requirement := range requirements {
+		requirements[i] = strings.TrimSpace(requirement)
+		if requirements[i] == "" {
+			return nil, fmt.Errorf("selector '%s' has an empty requirement", input)
+		}
+	}
+	return requirements, nil
+}
+
+// kubeSelectorFromDynamic converts a Terraform value into a selector. The value may either be
+// a selector string or an object with matchLabels / match_labels and matchExpressions /
+// match_expressions attributes, as in the Kubernetes LabelSelector API.
+func kubeSelectorFromDynamic(ctx context.Context, value types.Dynamic) (*kubeSelector, error) {
+	if str, ok := value.UnderlyingValue().(types.String); ok {
+		return parseKubeSelector(str.ValueString())
+	}
+
+	tfValue, err := value.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	goValue, err := tftypesToGo(tfValue)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := goValue.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("selector must be a string or an object with matchLabels and/or matchExpressions")
+	}
+
+	selector := kubeSelector{MatchLabels: map[string]string{}}
+	for attribute, attributeValue := range object {
+		switch attribute {
+		case "matchLabels", "match_labels":
+			if attributeValue == nil {
+				continue
+			}
+			labels, ok := attributeValue.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a map of strings", attribute)
+			}
+			for key, labelValue := range labels {
+				str, ok := labelValue.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s.%s must be a string", attribute, key)
+				}
+				selector.MatchLabels[key] = str
+			}
+		case "matchExpressions", "match_expressions":
+			if attributeValue == nil {
+				continue
+			}
+			expressions, ok := attributeValue.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a list of objects", attribute)
+			}
+			for i, expression := range expressions {
+				requirement, err := kubeSelectorRequirementFromGo(expression)
+				if err != nil {
+					return nil, fmt.Errorf("%s[%d]: %v", attribute, i, err)
+				}
+				selector.MatchExpressions = append(selector.MatchExpressions, *requirement)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported selector attribute '%s'", attribute)
+		}
+	}
+
+	if err := selector.validate(); err != nil {
+		return nil, fmt.Errorf("selector is invalid: %v", err)
+	}
+
+	return &selector, nil
+}
+
+func kubeSelectorRequirementFromGo(value any) (*kubeSelectorRequirement, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("must be an object with key, operator, and values")
+	}
+
+	var requirement kubeSelectorRequirement
+	for attribute, attributeValue := range object {
+		switch attribute {
+		case "key":
+			requirement.Key, _ = attributeValue.(string)
+		case "operator":
+			requirement.Operator, _ = attributeValue.(string)
+		case "values":
+			if attributeValue == nil {
+				continue
+			}
+			values, ok := attributeValue.([]any)
+			if !ok {
+				return nil, fmt.Errorf("values must be a list of strings")
+			}
+			for _, v := range values {
+				str, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("values must be a list of strings")
+				}
+				requirement.Values = append(requirement.Values, str)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported attribute '%s'", attribute)
+		}
+	}
+
+	if requirement.Key == "" || requirement.Operator == "" {
+		return nil, fmt.Errorf("key and operator are required")
+	}
+	return &requirement, nil
+}
+
+// tftypesToGo converts a known Terraform value into plain Go values: objects and maps become
+// map[string]any, lists, sets, and tuples become []any, and primitives become string, bool,
+// or *big.Float. Null values become nil.
+func tftypesToGo(value tftypes.Value) (any, error) {
+	if !value.IsKnown() {
+		return nil, fmt.Errorf("value must be known")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.Object{}) || typ.Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		result := make(map[string]any, len(elements))
+		for key, element := range elements {
+			converted, err := tftypesToGo(element)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = converted
+		}
+		return result, nil
+	case typ.Is(tftypes.List{}) || typ.Is(tftypes.Set{}) || typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		result := make([]any, 0, len(elements))
+		for _, element := range elements {
+			converted, err := tftypesToGo(element)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, converted)
+		}
+		return result, nil
+	case typ.Is(tftypes.String):
+		var str string
+		err := value.As(&str)
+		return str, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return b, err
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		err := value.As(&n)
+		return &n, err
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
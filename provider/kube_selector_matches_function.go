@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = KubeSelectorMatchesFunction{}
+)
+
+func NewKubeSelectorMatchesFunction() function.Function {
+	return KubeSelectorMatchesFunction{}
+}
+
+type KubeSelectorMatchesFunction struct{}
+
+func (f KubeSelectorMatchesFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kube_selector_matches"
+}
+
+func (f KubeSelectorMatchesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns true if the Kubernetes label selector matches the labels",
+		Description: "The selector may either be a selector string (e.g., 'a=b,c in (d,e),!f') or an object with matchLabels and matchExpressions (or match_labels and match_expressions) as in the Kubernetes LabelSelector API. Supports the In, NotIn, Exists, and DoesNotExist operators. An empty selector matches all labels.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The label selector",
+				Name:               "selector",
+			},
+			function.MapParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The labels to match against",
+				Name:               "labels",
+				ElementType:        types.StringType,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f KubeSelectorMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var selectorValue types.Dynamic
+	var labels map[string]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &selectorValue, &labels))
+	if resp.Error != nil {
+		return
+
+	}
+
+	selector, err := kubeSelectorFromDynamic(ctx, selectorValue)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	matches := selector.matches(labels)
+	tflog.Debug(ctx, "Matched Kubernetes label selector", map[string]any{"labels": labels, "matches": matches})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, matches))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = KubeSelectorParseFunction{}
+)
+
+func NewKubeSelectorParseFunction() function.Function {
+	return KubeSelectorParseFunction{}
+}
+
+type KubeSelectorParseFunction struct{}
+
+func (f KubeSelectorParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kube_selector_parse"
+}
+
+func (f KubeSelectorParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the match_labels and match_expressions of a Kubernetes label selector string",
+		Description: "Parses the selector string syntax used by kubectl (e.g., 'a=b,c in (d,e),!f'). Equality requirements become match_labels, and all other requirements become match_expressions.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The label selector string to parse",
+				Name:               "selector",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: kubeSelectorAttrTypes,
+		},
+	}
+}
+
+func (f KubeSelectorParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+
+	}
+
+	selector, err := parseKubeSelector(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	tflog.Debug(ctx, "Parsed Kubernetes label selector", map[string]any{
+		"selector":          input,
+		"match_labels":      len(selector.MatchLabels),
+		"match_expressions": len(selector.MatchExpressions),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, selector))
+}
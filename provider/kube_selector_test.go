@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestKubeSelectorMatches(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{
+		"panfactum.com/environment": "production",
+		"panfactum.com/module":      "vault",
+		"tier":                      "backend",
+	}
+
+	tests := []struct {
+		selector string
+		expected bool
+	}{
+		{"", true},
+		{"panfactum.com/environment=production", true},
+		{"panfactum.com/environment==production", true},
+		{"panfactum.com/environment=development", false},
+		{"panfactum.com/environment!=development", true},
+		{"missing!=value", true},
+		{"tier in (frontend, backend)", true},
+		{"tier in (frontend)", false},
+		{"missing in (value)", false},
+		{"tier notin (frontend)", true},
+		{"tier notin (backend)", false},
+		{"missing notin (value)", true},
+		{"tier", true},
+		{"missing", false},
+		{"!missing", true},
+		{"!tier", false},
+		{"panfactum.com/module=vault,tier in (backend),!missing", true},
+		{"panfactum.com/module=vault,tier=frontend", false},
+		{"tier=backend,tier=frontend", false},
+	}
+
+	for _, test := range tests {
+		selector, err := parseKubeSelector(test.selector)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.selector, err)
+			continue
+		}
+		if actual := selector.matches(labels); actual != test.expected {
+			t.Errorf("%q: expected %t, got %t", test.selector, test.expected, actual)
+		}
+	}
+}
+
+func TestParseKubeSelector_Malformed(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"a=b,",
+		",a=b",
+		"tier in (a,b",
+		"tier in ((a))",
+		"-invalid-key=a",
+		"a=-invalid-value-",
+		"a b",
+	} {
+		if _, err := parseKubeSelector(input); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestKubeSelectorValidate(t *testing.T) {
+	t.Parallel()
+
+	invalid := []kubeSelector{
+		{MatchExpressions: []kubeSelectorRequirement{{Key: "a", Operator: "In"}}},
+		{MatchExpressions: []kubeSelectorRequirement{{Key: "a", Operator: "Exists", Values: []string{"b"}}}},
+		{MatchExpressions: []kubeSelectorRequirement{{Key: "a", Operator: "Gt", Values: []string{"1"}}}},
+		{MatchLabels: map[string]string{"a/b/c": "d"}},
+	}
+
+	for _, selector := range invalid {
+		if err := selector.validate(); err == nil {
+			t.Errorf("%+v: expected an error", selector)
+		}
+	}
+}
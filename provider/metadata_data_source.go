@@ -5,29 +5,45 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 /**************************************************************
   Provider Definition
  **************************************************************/
 
-var _ datasource.DataSource = &metadataDataSource{}
+var _ datasource.DataSourceWithConfigure = &metadataDataSource{}
+
+// The provider attributes that the metadata derives from
+var metadataProviderAttributes = []string{
+	"environment",
+	"environment_classes",
+	"region",
+	"root_module",
+	"stack_version",
+	"stack_commit",
+	"is_local",
+	"kube_config_context",
+	"kube_api_server",
+	"kube_cluster_name",
+	"sla_target",
+}
 
 func NewMetadataDataSource() datasource.DataSource {
 	return &metadataDataSource{}
 }
 
 type metadataDataSource struct {
-	ProviderData *PanfactumProvider
+	panfactumDataSource
 }
 
 type metadataDataSourceModel struct {
 	Environment       types.String `tfsdk:"environment"`
 	Region            types.String `tfsdk:"region"`
+	AWSPartition      types.String `tfsdk:"aws_partition"`
 	RootModule        types.String `tfsdk:"root_module"`
 	StackVersion      types.String `tfsdk:"stack_version"`
 	StackCommit       types.String `tfsdk:"stack_commit"`
@@ -37,6 +53,10 @@ type metadataDataSourceModel struct {
 	KubeAPIServer     types.String `tfsdk:"kube_api_server"`
 	KubeClusterName   types.String `tfsdk:"kube_cluster_name"`
 	SLATarget         types.Int32  `tfsdk:"sla_target"`
+
+	EnvironmentClass       types.String `tfsdk:"environment_class"`
+	IsProduction           types.Bool   `tfsdk:"is_production"`
+	IsProductionDeployment types.Bool   `tfsdk:"is_production_deployment"`
 }
 
 func (d *metadataDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,8 +65,8 @@ func (d *metadataDataSource) Metadata(ctx context.Context, req datasource.Metada
 
 func (d *metadataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "Provides metadata about the IaC deployment context.",
-		MarkdownDescription: "Provides metadata about the IaC deployment context.",
+		Description:         "Provides metadata about the IaC deployment context." + unknownProviderConfigurationDescription,
+		MarkdownDescription: "Provides metadata about the IaC deployment context." + unknownProviderConfigurationDescription,
 
 		Attributes: map[string]schema.Attribute{
 			"environment": schema.StringAttribute{
@@ -59,6 +79,11 @@ func (d *metadataDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "The name of the region that you are currently deploying infrastructure to",
 				Computed:            true,
 			},
+			"aws_partition": schema.StringAttribute{
+				Description:         "The AWS partition that contains the region (e.g., aws or aws-cn). Null if the region is not set or is not a valid AWS region.",
+				MarkdownDescription: "The AWS partition that contains the `region` (e.g., `aws` or `aws-cn`). Null if the `region` is not set or is not a valid AWS region.",
+				Computed:            true,
+			},
 			"root_module": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The name of the root / top-level module that you are currently deploying infrastructure with",
@@ -104,31 +129,32 @@ func (d *metadataDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "The Panfactum SLA target for Panfactum modules",
 				Computed:            true,
 			},
+			"environment_class": schema.StringAttribute{
+				Description:         "The class of the environment that you are currently deploying infrastructure to (production, staging, development, or local). Null if the environment is not classified.",
+				MarkdownDescription: "The class of the environment that you are currently deploying infrastructure to (`production`, `staging`, `development`, or `local`). Null if the environment is not classified.",
+				Computed:            true,
+			},
+			"is_production": schema.BoolAttribute{
+				Description:         "Whether the environment that you are currently deploying infrastructure to is classified as production",
+				MarkdownDescription: "Whether the environment that you are currently deploying infrastructure to is classified as `production`",
+				Computed:            true,
+			},
+			"is_production_deployment": schema.BoolAttribute{
+				Description:         "Whether this is a non-local deployment to a production environment. Use this to guard production-only behavior such as deletion protection.",
+				MarkdownDescription: "Whether this is a non-local deployment to a `production` environment. Use this to guard production-only behavior such as deletion protection.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
-func (d *metadataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	data, ok := req.ProviderData.(*PanfactumProvider)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PanfactumProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (d *metadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
+	// Guard against reads before the provider has been configured
+	if !d.checkProviderConfigured(req, resp) {
 		return
 	}
 
-	d.ProviderData = data
-}
-
-func (d *metadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-
 	var data metadataDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -137,8 +163,14 @@ func (d *metadataDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	// The metadata is the provider configuration, so it must be known
+	if !d.checkProviderAttributesKnown(req, resp, metadataProviderAttributes...) {
+		return
+	}
+
 	data.Environment = d.ProviderData.Environment
 	data.Region = d.ProviderData.Region
+	data.AWSPartition = getAWSPartition(d.ProviderData.Region)
 	data.RootModule = d.ProviderData.RootModule
 	data.StackCommit = d.ProviderData.StackCommit
 	data.StackVersion = d.ProviderData.StackVersion
@@ -148,7 +180,36 @@ func (d *metadataDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.KubeAPIServer = d.ProviderData.KubeAPIServer
 	data.KubeClusterName = d.ProviderData.KubeClusterName
 	data.SLATarget = d.ProviderData.SLATarget
+	data.EnvironmentClass = d.ProviderData.EnvironmentClass
+
+	if data.EnvironmentClass.IsUnknown() || d.ProviderData.IsLocal.IsUnknown() {
+		data.IsProduction = types.BoolUnknown()
+		data.IsProductionDeployment = types.BoolUnknown()
+	} else {
+		isProduction := data.EnvironmentClass.ValueString() == EnvironmentClassProduction
+		data.IsProduction = types.BoolValue(isProduction)
+		data.IsProductionDeployment = types.BoolValue(isProduction && !d.ProviderData.IsLocal.ValueBool())
+	}
+
+	tflog.Debug(ctx, "Computed deployment metadata", map[string]any{
+		"environment":              data.Environment.String(),
+		"environment_class":        data.EnvironmentClass.String(),
+		"is_production_deployment": data.IsProductionDeployment.String(),
+	})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func getAWSPartition(region types.String) types.String {
+	if region.IsUnknown() {
+		return types.StringUnknown()
+	} else if region.IsNull() {
+		return types.StringNull()
+	}
+	partition, err := awsPartitionForRegion(region.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(partition)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+// Not parallel as the provider reads the kubeconfig path from the environment
+func TestMetadataDataSource(t *testing.T) {
+	t.Setenv("KUBE_CONFIG_PATH", "testdata/kubeconfig.yaml")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                provider "pf" {
+                    environment         = "production"
+                    region              = "us-east-2"
+                    kube_config_context = "production-primary"
+                    sla_target          = 2
+                }
+
+                data "pf_metadata" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("environment"), knownvalue.StringExact("production")),
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("aws_partition"), knownvalue.StringExact("aws")),
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("kube_config_path"), knownvalue.StringExact("testdata/kubeconfig.yaml")),
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("kube_cluster_name"), knownvalue.StringExact("production-primary")),
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("sla_target"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("is_production"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
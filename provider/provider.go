@@ -7,13 +7,18 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gopkg.in/yaml.v3"
 	"os"
 	"path/filepath"
@@ -21,7 +26,11 @@ import (
 
 type PanfactumProvider struct {
 	*PanfactumProviderModel
-	KubeConfigPath string
+	KubeConfigPath   string
+	EnvironmentClass types.String
+
+	// Names of the configured attributes whose values are not known until apply
+	UnknownAttributes []string
 }
 
 type PanfactumProviderModel struct {
@@ -36,6 +45,24 @@ type PanfactumProviderModel struct {
 	KubeAPIServer     types.String `tfsdk:"kube_api_server"`
 	KubeClusterName   types.String `tfsdk:"kube_cluster_name"`
 	SLATarget         types.Int32  `tfsdk:"sla_target"`
+
+	EnvironmentClasses     types.Map  `tfsdk:"environment_classes"`
+	PreventLocalProduction types.Bool `tfsdk:"prevent_local_production"`
+	Strict                 types.Bool `tfsdk:"strict"`
+}
+
+const (
+	EnvironmentClassProduction  = "production"
+	EnvironmentClassStaging     = "staging"
+	EnvironmentClassDevelopment = "development"
+	EnvironmentClassLocal       = "local"
+)
+
+var environmentClasses = []string{
+	EnvironmentClassProduction,
+	EnvironmentClassStaging,
+	EnvironmentClassDevelopment,
+	EnvironmentClassLocal,
 }
 
 func New() provider.Provider {
@@ -81,9 +108,12 @@ func (p *PanfactumProvider) Schema(ctx context.Context, req provider.SchemaReque
 			},
 			"extra_tags": schema.MapAttribute{
 				Optional:            true,
-				Description:         "Extra tags to apply to all resources",
-				MarkdownDescription: "Extra tags to apply to all resources",
+				Description:         "Extra tags to apply to all resources. Keys and values that are not valid as both AWS tags and Kubernetes labels are reported, as they will be sanitized.",
+				MarkdownDescription: "Extra tags to apply to all resources. Keys and values that are not valid as both AWS tags and Kubernetes labels are reported, as they will be sanitized.",
 				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					extraTagsValidator{},
+				},
 			},
 			"kube_config_context": schema.StringAttribute{
 				Description:         "The name of the context from KUBE_CONFIG that is being used to deploy infrastructure",
@@ -109,6 +139,25 @@ func (p *PanfactumProvider) Schema(ctx context.Context, req provider.SchemaReque
 					int32validator.AtMost(3),
 				},
 			},
+			"environment_classes": schema.MapAttribute{
+				Description:         "A mapping of environment names to environment classes (production, staging, development, or local). Environments that are not mapped are classified by their name if it matches a class.",
+				MarkdownDescription: "A mapping of environment names to environment classes (`production`, `staging`, `development`, or `local`). Environments that are not mapped are classified by their name if it matches a class.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(environmentClasses...)),
+				},
+			},
+			"prevent_local_production": schema.BoolAttribute{
+				Description:         "If true, the provider will fail to configure when is_local is true and the environment class is production",
+				MarkdownDescription: "If `true`, the provider will fail to configure when `is_local` is `true` and the environment class is `production`",
+				Optional:            true,
+			},
+			"strict": schema.BoolAttribute{
+				Description:         "If true, invalid provider configuration values (e.g., a region that is not a valid AWS region) will be reported as errors instead of warnings",
+				MarkdownDescription: "If `true`, invalid provider configuration values (e.g., a `region` that is not a valid AWS region) will be reported as errors instead of warnings",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -119,34 +168,72 @@ func (p *PanfactumProvider) Configure(ctx context.Context, req provider.Configur
 
 	// Step 1: Load the explicitly set data
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	resp.Diagnostics.Append(validateProviderModel(&model, model.Strict.ValueBool())...)
+	newProvider.UnknownAttributes = getUnknownAttributes(req.Config.Raw)
+	if len(newProvider.UnknownAttributes) > 0 {
+		tflog.Debug(ctx, "Provider configuration contains unknown values", map[string]any{"unknown_attributes": newProvider.UnknownAttributes})
+	}
 
 	// Step 2: Load config from environment variables
 	kubeCfgPath := os.Getenv("KUBE_CONFIG_PATH")
 	if kubeCfgPath != "" {
 		newProvider.KubeConfigPath = filepath.Clean(kubeCfgPath)
+		tflog.Debug(ctx, "Using kubeconfig from KUBE_CONFIG_PATH", map[string]any{"kube_config_path": newProvider.KubeConfigPath})
 	} else {
 		homePath, err := os.UserHomeDir()
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to load user home directory", fmt.Sprintf("%v", err))
 		}
 		newProvider.KubeConfigPath = filepath.Join(homePath, ".kube/config")
+		tflog.Debug(ctx, "Using default kubeconfig", map[string]any{"kube_config_path": newProvider.KubeConfigPath})
 	}
 
 	// Step 3: Load the cluster name based on the current context
 	kubeCfgContext := newProvider.KubeConfigContext.ValueString()
-	if kubeCfgContext != "" {
+	if newProvider.KubeConfigContext.IsUnknown() {
+		newProvider.KubeClusterName = types.StringUnknown()
+	} else if kubeCfgContext != "" {
 		if clusterName, err := getKubeClusterName(newProvider.KubeConfigPath, kubeCfgContext); err != nil {
-			resp.Diagnostics.AddError("Unable to load cluster name", fmt.Sprintf("%v", err))
+			resp.Diagnostics.AddAttributeError(path.Root("kube_config_context"), "Unable to load cluster name", fmt.Sprintf("%v", err))
 		} else {
 			newProvider.KubeClusterName = types.StringValue(clusterName)
+			tflog.Debug(ctx, "Resolved cluster name from kubeconfig context", map[string]any{
+				"kube_config_context": kubeCfgContext,
+				"kube_cluster_name":   clusterName,
+			})
 		}
 	}
 
 	// Step 4: Apply Defaults
-	if newProvider.SLATarget.IsNull() || newProvider.SLATarget.IsUnknown() {
+	if newProvider.SLATarget.IsNull() {
 		newProvider.SLATarget = types.Int32Value(3)
 	}
 
+	// Step 5: Classify the environment
+	newProvider.EnvironmentClass = getEnvironmentClass(newProvider.Environment, newProvider.EnvironmentClasses)
+	if newProvider.PreventLocalProduction.ValueBool() && newProvider.IsLocal.ValueBool() && newProvider.EnvironmentClass.ValueString() == EnvironmentClassProduction {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_local"),
+			"Local deployment to production environment",
+			fmt.Sprintf("The environment '%s' is classified as production, and local deployments to production are disabled by prevent_local_production.", newProvider.Environment.ValueString()),
+		)
+	}
+
+	tflog.Info(ctx, "Configured Panfactum provider", map[string]any{
+		"environment":         newProvider.Environment.String(),
+		"environment_class":   newProvider.EnvironmentClass.String(),
+		"region":              newProvider.Region.String(),
+		"root_module":         newProvider.RootModule.String(),
+		"stack_version":       newProvider.StackVersion.String(),
+		"stack_commit":        newProvider.StackCommit.String(),
+		"is_local":            newProvider.IsLocal.String(),
+		"kube_config_path":    newProvider.KubeConfigPath,
+		"kube_config_context": newProvider.KubeConfigContext.String(),
+		"kube_api_server":     newProvider.KubeAPIServer.String(),
+		"kube_cluster_name":   newProvider.KubeClusterName.String(),
+		"sla_target":          newProvider.SLATarget.String(),
+	})
+
 	resp.DataSourceData = &newProvider
 	resp.ResourceData = &newProvider
 }
@@ -160,6 +247,10 @@ func (p *PanfactumProvider) DataSources(ctx context.Context) []func() datasource
 		NewKubeLabelsDataSource,
 		NewAWSTagsDataSource,
 		NewMetadataDataSource,
+		NewKubeContextDataSource,
+		NewKubeContextsDataSource,
+		NewSLAProfileDataSource,
+		NewKubeSchedulingDataSource,
 	}
 }
 
@@ -170,6 +261,30 @@ func (p *PanfactumProvider) Functions(ctx context.Context) []func() function.Fun
 		NewCIDRContainsFunction,
 		NewCIDRsOverlapFunction,
 		NewCIDRCountHosts,
+		NewResourceNameFunction,
+		NewSanitizeKubeNameFunction,
+		NewValidateKubeNameFunction,
+		NewKubeQuantityParseFunction,
+		NewKubeQuantityFormatFunction,
+		NewKubeQuantityAddFunction,
+		NewKubeQuantityMultiplyFunction,
+		NewKubeQuantityCompareFunction,
+		NewKubeSelectorMatchesFunction,
+		NewKubeSelectorParseFunction,
+		NewCronValidateFunction,
+		NewCronNextFunction,
+		NewDurationParseFunction,
+		NewDurationFormatFunction,
+		NewSemverCompareFunction,
+		NewSemverSatisfiesFunction,
+		NewStackVersionCompareFunction,
+		NewARNParseFunction,
+		NewARNBuildFunction,
+		NewARNPartitionForRegionFunction,
+		NewIAMPolicyMergeFunction,
+		NewIAMPolicyValidateFunction,
+		NewShortHashFunction,
+		NewStableIDFunction,
 	}
 }
 
@@ -178,32 +293,181 @@ func (p *PanfactumProvider) Functions(ctx context.Context) []func() function.Fun
  **************************************************************/
 
 type KubeConfig struct {
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthority     string `yaml:"certificate-authority"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
 	Contexts []struct {
 		Name    string `yaml:"name"`
 		Context struct {
-			Cluster string `yaml:"cluster"`
+			Cluster   string `yaml:"cluster"`
+			User      string `yaml:"user"`
+			Namespace string `yaml:"namespace"`
 		} `yaml:"context"`
 	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Exec *KubeConfigExec `yaml:"exec"`
+		} `yaml:"user"`
+	} `yaml:"users"`
 }
 
-func getKubeClusterName(kubeConfigPath string, context string) (string, error) {
+type KubeConfigExec struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Command    string   `yaml:"command"`
+	Args       []string `yaml:"args"`
+	Env        []struct {
+		Name  string `yaml:"name"`
+		Value string `yaml:"value"`
+	} `yaml:"env"`
+}
+
+// KubeContext is the fully resolved view of a single kubeconfig context,
+// joining the context with its referenced cluster and user entries
+type KubeContext struct {
+	Name                     string
+	ClusterName              string
+	Server                   string
+	CertificateAuthority     string
+	CertificateAuthorityData string
+	Namespace                string
+	User                     string
+	Exec                     *KubeConfigExec
+}
+
+func loadKubeConfig(kubeConfigPath string) (*KubeConfig, error) {
 	file, err := os.Open(kubeConfigPath)
 	if err != nil {
-		return "", fmt.Errorf("error opening YAML file: %v", err)
+		return nil, fmt.Errorf("error opening YAML file: %v", err)
 	}
 	defer file.Close()
 
 	var cfg KubeConfig
 	decoder := yaml.NewDecoder(file)
 	if err := decoder.Decode(&cfg); err != nil {
-		return "", fmt.Errorf("error decoding YAML: %v", err)
+		return nil, fmt.Errorf("error decoding YAML: %v", err)
 	}
 
+	return &cfg, nil
+}
+
+// resolveKubeContext joins the named context with its cluster and user entries.
+// Relative certificate authority paths are resolved against the directory of
+// the kubeconfig file, matching the behavior of kubectl.
+func (cfg *KubeConfig) resolveKubeContext(kubeConfigPath string, context string) (*KubeContext, error) {
 	for _, contextConfig := range cfg.Contexts {
-		if contextConfig.Name == context {
-			return contextConfig.Context.Cluster, nil
+		if contextConfig.Name != context {
+			continue
 		}
+
+		kubeContext := KubeContext{
+			Name:        contextConfig.Name,
+			ClusterName: contextConfig.Context.Cluster,
+			Namespace:   contextConfig.Context.Namespace,
+			User:        contextConfig.Context.User,
+		}
+
+		for _, clusterConfig := range cfg.Clusters {
+			if clusterConfig.Name == kubeContext.ClusterName {
+				kubeContext.Server = clusterConfig.Cluster.Server
+				kubeContext.CertificateAuthorityData = clusterConfig.Cluster.CertificateAuthorityData
+				kubeContext.CertificateAuthority = clusterConfig.Cluster.CertificateAuthority
+				if kubeContext.CertificateAuthority != "" && !filepath.IsAbs(kubeContext.CertificateAuthority) {
+					kubeContext.CertificateAuthority = filepath.Join(filepath.Dir(kubeConfigPath), kubeContext.CertificateAuthority)
+				}
+				break
+			}
+		}
+
+		for _, userConfig := range cfg.Users {
+			if userConfig.Name == kubeContext.User {
+				kubeContext.Exec = userConfig.User.Exec
+				break
+			}
+		}
+
+		return &kubeContext, nil
 	}
 
-	return "", fmt.Errorf("no context name %s found in kubeconfig file at %s", context, kubeConfigPath)
+	return nil, fmt.Errorf("no context name %s found in kubeconfig file at %s", context, kubeConfigPath)
+}
+
+func getKubeContext(kubeConfigPath string, context string) (*KubeContext, error) {
+	cfg, err := loadKubeConfig(kubeConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.resolveKubeContext(kubeConfigPath, context)
+}
+
+func getKubeContexts(kubeConfigPath string) ([]KubeContext, error) {
+	cfg, err := loadKubeConfig(kubeConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeContexts := make([]KubeContext, 0, len(cfg.Contexts))
+	for _, contextConfig := range cfg.Contexts {
+		kubeContext, err := cfg.resolveKubeContext(kubeConfigPath, contextConfig.Name)
+		if err != nil {
+			return nil, err
+		}
+		kubeContexts = append(kubeContexts, *kubeContext)
+	}
+
+	return kubeContexts, nil
+}
+
+// getEnvironmentClass returns the class of the environment from the explicit
+// mapping if present, otherwise from the environment name itself if it is a class name.
+// Returns null if the environment cannot be classified and unknown if its inputs are unknown.
+func getEnvironmentClass(environment types.String, mapping types.Map) types.String {
+	if environment.IsUnknown() || mapping.IsUnknown() {
+		return types.StringUnknown()
+	}
+	if environment.IsNull() {
+		return types.StringNull()
+	}
+
+	if class, ok := mapping.Elements()[environment.ValueString()].(types.String); ok && !class.IsNull() {
+		return class
+	}
+
+	for _, class := range environmentClasses {
+		if environment.ValueString() == class {
+			return types.StringValue(class)
+		}
+	}
+
+	return types.StringNull()
+}
+
+// getUnknownAttributes returns the names of the attributes of the configuration whose
+// values are not fully known, including collections with unknown elements
+func getUnknownAttributes(config tftypes.Value) []string {
+	var attributes map[string]tftypes.Value
+	if err := config.As(&attributes); err != nil {
+		return nil
+	}
+
+	var unknown []string
+	for _, name := range sortedKeys(attributes) {
+		if !attributes[name].IsFullyKnown() {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+func getKubeClusterName(kubeConfigPath string, context string) (string, error) {
+	kubeContext, err := getKubeContext(kubeConfigPath, context)
+	if err != nil {
+		return "", err
+	}
+	return kubeContext.ClusterName, nil
 }
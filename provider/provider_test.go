@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetEnvironmentClass(t *testing.T) {
+	t.Parallel()
+
+	mapping := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"prod-eu":    types.StringValue(EnvironmentClassProduction),
+		"production": types.StringValue(EnvironmentClassStaging),
+	})
+
+	tests := []struct {
+		name        string
+		environment types.String
+		mapping     types.Map
+		expected    types.String
+	}{
+		{"mapped", types.StringValue("prod-eu"), mapping, types.StringValue(EnvironmentClassProduction)},
+		{"mapping overrides name", types.StringValue("production"), mapping, types.StringValue(EnvironmentClassStaging)},
+		{"name matches class", types.StringValue("development"), mapping, types.StringValue(EnvironmentClassDevelopment)},
+		{"name matches class without mapping", types.StringValue("local"), types.MapNull(types.StringType), types.StringValue(EnvironmentClassLocal)},
+		{"unclassified", types.StringValue("sandbox"), mapping, types.StringNull()},
+		{"null environment", types.StringNull(), mapping, types.StringNull()},
+		{"unknown environment", types.StringUnknown(), mapping, types.StringUnknown()},
+		{"unknown mapping", types.StringValue("prod-eu"), types.MapUnknown(types.StringType), types.StringUnknown()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if actual := getEnvironmentClass(test.environment, test.mapping); !actual.Equal(test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetUnknownAttributes(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"environment": tftypes.String,
+		"region":      tftypes.String,
+		"extra_tags":  tftypes.Map{ElementType: tftypes.String},
+		"sla_target":  tftypes.Number,
+	}}
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"region":      tftypes.NewValue(tftypes.String, "us-east-2"),
+		"extra_tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"team": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		"sla_target": tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	expected := []string{"environment", "extra_tags"}
+	if actual := getUnknownAttributes(config); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestProviderConfigureUnknownValues(t *testing.T) {
+	t.Setenv("KUBE_CONFIG_PATH", "testdata/kubeconfig.yaml")
+	providerData := configureTestProvider(t, map[string]tftypes.Value{
+		"environment":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"kube_config_context": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"sla_target":          tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+	})
+	if expected := []string{"environment", "kube_config_context", "sla_target"}; !reflect.DeepEqual(providerData.UnknownAttributes, expected) {
+		t.Errorf("expected unknown attributes %v, got %v", expected, providerData.UnknownAttributes)
+	}
+	if !providerData.KubeClusterName.IsUnknown() {
+		t.Errorf("expected the cluster name of an unknown context to be unknown, got %s", providerData.KubeClusterName)
+	}
+	if !providerData.SLATarget.IsUnknown() {
+		t.Errorf("expected an unknown SLA target to remain unknown rather than default, got %s", providerData.SLATarget)
+	}
+	if !providerData.EnvironmentClass.IsUnknown() {
+		t.Errorf("expected the class of an unknown environment to be unknown, got %s", providerData.EnvironmentClass)
+	}
+}
+
+func TestGetKubeClusterName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		context  string
+		expected string
+	}{
+		{"production-primary", "production-primary"},
+		{"development-primary", "development-primary"},
+	}
+
+	for _, test := range tests {
+		actual, err := getKubeClusterName("testdata/kubeconfig.yaml", test.context)
+		if err != nil {
+			t.Errorf("getKubeClusterName(%s) returned an error: %v", test.context, err)
+		} else if actual != test.expected {
+			t.Errorf("getKubeClusterName(%s) = %s, expected %s", test.context, actual, test.expected)
+		}
+	}
+}
+
+func TestGetKubeClusterName_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		kubeConfigPath string
+		context        string
+		expected       string
+	}{
+		{"testdata/kubeconfig.yaml", "staging-primary", "no context name staging-primary found in kubeconfig file at testdata/kubeconfig.yaml"},
+		{"testdata/missing.yaml", "production-primary", "error opening YAML file: open testdata/missing.yaml: no such file or directory"},
+		{"testdata/kubeconfig-invalid.yaml", "production-primary", "error decoding YAML: "},
+	}
+
+	for _, test := range tests {
+		if _, err := getKubeClusterName(test.kubeConfigPath, test.context); err == nil || !strings.HasPrefix(err.Error(), test.expected) {
+			t.Errorf("getKubeClusterName(%s, %s) returned error %v, expected %s", test.kubeConfigPath, test.context, err, test.expected)
+		}
+	}
+}
+
+func TestGetKubeContexts(t *testing.T) {
+	t.Parallel()
+
+	contexts, err := getKubeContexts("testdata/kubeconfig.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []KubeContext{
+		{
+			Name:                     "production-primary",
+			ClusterName:              "production-primary",
+			Server:                   "https://primary.example.com",
+			CertificateAuthorityData: "Y2VydGlmaWNhdGU=",
+			User:                     "production-primary",
+			Exec:                     contexts[0].Exec,
+		},
+		{
+			Name:        "development-primary",
+			ClusterName: "development-primary",
+			Server:      "https://development.example.com",
+			// Relative paths are resolved against the directory of the kubeconfig file
+			CertificateAuthority: "testdata/certs/development.crt",
+			Namespace:            "default",
+			User:                 "development-primary",
+		},
+	}
+	if !reflect.DeepEqual(contexts, expected) {
+		t.Errorf("expected contexts %+v, got %+v", expected, contexts)
+	}
+	if contexts[0].Exec == nil || contexts[0].Exec.Command != "pf" {
+		t.Errorf("expected the exec configuration of the production-primary user, got %+v", contexts[0].Exec)
+	}
+}
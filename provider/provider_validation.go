@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var stackCommitRegex = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+// providerValidator checks a single attribute of the provider configuration
+// and returns a description of the problem, or an empty string if the value is valid
+type providerValidator struct {
+	path     path.Path
+	summary  string
+	value    func(model *PanfactumProviderModel) types.String
+	validate func(value string) string
+}
+
+var providerValidators = []providerValidator{
+	{
+		path:    path.Root("region"),
+		summary: "Invalid AWS region",
+		value:   func(model *PanfactumProviderModel) types.String { return model.Region },
+		validate: func(value string) string {
+			if _, err := awsPartitionForRegion(value); err != nil {
+				return fmt.Sprintf("%v.", err)
+			}
+			return ""
+		},
+	},
+	{
+		path:    path.Root("kube_api_server"),
+		summary: "Invalid Kubernetes API server address",
+		value:   func(model *PanfactumProviderModel) types.String { return model.KubeAPIServer },
+		validate: func(value string) string {
+			parsed, err := url.Parse(value)
+			if err != nil {
+				return fmt.Sprintf("'%s' is not a valid URL: %v", value, err)
+			}
+			if parsed.Scheme != "https" || parsed.Host == "" {
+				return fmt.Sprintf("'%s' is not an HTTPS address (e.g., https://1.2.3.4).", value)
+			}
+			return ""
+		},
+	},
+	{
+		path:    path.Root("stack_version"),
+		summary: "Invalid Panfactum Stack version",
+		value:   func(model *PanfactumProviderModel) types.String { return model.StackVersion },
+		validate: func(value string) string {
+			// Accept the same versions as stack_version_compare
+			_, err := parseStackVersion(value)
+			if err == nil {
+				return ""
+			}
+			if _, semverErr := parseSemver(value); semverErr == nil {
+				return ""
+			}
+			return fmt.Sprintf("%v, and it is not a semantic version either.", err)
+		},
+	},
+	{
+		path:    path.Root("stack_commit"),
+		summary: "Invalid Panfactum Stack commit",
+		value:   func(model *PanfactumProviderModel) types.String { return model.StackCommit },
+		validate: func(value string) string {
+			if !stackCommitRegex.MatchString(value) {
+				return fmt.Sprintf("'%s' is not a lowercase hexadecimal git commit SHA.", value)
+			}
+			return ""
+		},
+	},
+}
+
+// validateProviderModel checks the provider configuration for values that are
+// syntactically allowed by the schema but cannot be correct. Problems are reported
+// as warnings unless strict is true, in which case they are reported as errors.
+// Unknown and null values are not validated.
+func validateProviderModel(model *PanfactumProviderModel, strict bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range providerValidators {
+		value := v.value(model)
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if detail := v.validate(value.ValueString()); detail != "" {
+			addProviderValidationDiagnostic(&diags, v.path, v.summary, detail, strict)
+		}
+	}
+
+	return diags
+}
+
+// extraTagsValidator validates the extra_tags attribute with validateExtraTags so that
+// the problems are reported when the provider configuration is validated. It reads strict
+// from the rest of the configuration.
+type extraTagsValidator struct{}
+
+var _ validator.Map = extraTagsValidator{}
+
+func (v extraTagsValidator) Description(ctx context.Context) string {
+	return "keys and values must be valid as both AWS tags and Kubernetes labels"
+}
+
+func (v extraTagsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v extraTagsValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	var strict types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("strict"), &strict)...)
+	resp.Diagnostics.Append(validateExtraTags(req.Path, req.ConfigValue, strict.ValueBool())...)
+}
+
+// validateExtraTags checks that the extra tags are used as-is by the data sources. Keys and
+// values that the AWS tag or Kubernetes label sanitizers would change are reported along
+// with the values that they would be changed to.
+func validateExtraTags(attributePath path.Path, extraTags types.Map, strict bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if extraTags.IsNull() || extraTags.IsUnknown() {
+		return diags
+	}
+
+	elements := extraTags.Elements()
+	for _, key := range sortedKeys(elements) {
+		tagPath := attributePath.AtMapKey(key)
+
+		if changes := getSanitizerChanges(key, sanitizeAWSTagKey(key), sanitizeKubeLabelKey(key)); changes != "" {
+			addProviderValidationDiagnostic(&diags, tagPath, "Invalid extra tag key",
+				fmt.Sprintf("The key '%s' will be changed to %s. Use a key that is valid as both an AWS tag key and a Kubernetes label key.", key, changes),
+				strict,
+			)
+		}
+
+		value, ok := elements[key].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if changes := getSanitizerChanges(value.ValueString(), sanitizeAWSTagValue(value.ValueString()), sanitizeKubeLabelValue(value.ValueString())); changes != "" {
+			addProviderValidationDiagnostic(&diags, tagPath, "Invalid extra tag value",
+				fmt.Sprintf("The value '%s' will be changed to %s. Use a value that is valid as both an AWS tag value and a Kubernetes label value.", value.ValueString(), changes),
+				strict,
+			)
+		}
+	}
+
+	return diags
+}
+
+// getSanitizerChanges describes how the AWS tag and Kubernetes label sanitizers change
+// the input, or returns an empty string if neither changes it
+func getSanitizerChanges(input string, awsTag string, kubeLabel string) string {
+	var changes []string
+	if awsTag != input {
+		changes = append(changes, fmt.Sprintf("'%s' in AWS tags", awsTag))
+	}
+	if kubeLabel != input {
+		changes = append(changes, fmt.Sprintf("'%s' in Kubernetes labels", kubeLabel))
+	}
+	return strings.Join(changes, " and ")
+}
+
+// addProviderValidationDiagnostic reports a problem with the provider configuration as a
+// warning, or as an error in strict mode
+func addProviderValidationDiagnostic(diags *diag.Diagnostics, attributePath path.Path, summary string, detail string, strict bool) {
+	if strict {
+		diags.AddAttributeError(attributePath, summary, detail)
+	} else {
+		diags.AddAttributeWarning(attributePath, summary, detail+" Set strict to true to make this an error.")
+	}
+}
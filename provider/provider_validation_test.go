@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"testing"
+)
+
+func TestValidateProviderModel_Valid(t *testing.T) {
+	t.Parallel()
+
+	for _, stackVersion := range []string{"edge", "main", "local", "edge.24-10-01", "24-10.1", "stable.24-10.1", "1.2.3", "v1.2.3-rc.1"} {
+		model := PanfactumProviderModel{
+			Region:        types.StringValue("us-east-2"),
+			KubeAPIServer: types.StringValue("https://10.0.0.1:443"),
+			StackVersion:  types.StringValue(stackVersion),
+			StackCommit:   types.StringValue("0123456789abcdef0123456789abcdef01234567"),
+		}
+		if diags := validateProviderModel(&model, true); diags.HasError() {
+			t.Errorf("expected no errors for stack_version %s, got %v", stackVersion, diags)
+		}
+	}
+
+	for _, region := range []string{"us-gov-west-1", "cn-north-1", "ap-southeast-4", "il-central-1", "eu-isoe-west-1", "us-isof-south-1", "eusc-de-east-1"} {
+		model := PanfactumProviderModel{Region: types.StringValue(region)}
+		if diags := validateProviderModel(&model, true); diags.HasError() {
+			t.Errorf("expected no errors for region %s, got %v", region, diags)
+		}
+	}
+}
+
+func TestValidateProviderModel_SkipsNullAndUnknown(t *testing.T) {
+	t.Parallel()
+
+	model := PanfactumProviderModel{
+		Region:        types.StringUnknown(),
+		KubeAPIServer: types.StringNull(),
+	}
+	if diags := validateProviderModel(&model, true); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestValidateProviderModel_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		model PanfactumProviderModel
+		path  path.Path
+	}{
+		{"region", PanfactumProviderModel{Region: types.StringValue("us-east")}, path.Root("region")},
+		{"kube_api_server http", PanfactumProviderModel{KubeAPIServer: types.StringValue("http://10.0.0.1")}, path.Root("kube_api_server")},
+		{"kube_api_server no scheme", PanfactumProviderModel{KubeAPIServer: types.StringValue("10.0.0.1")}, path.Root("kube_api_server")},
+		{"stack_version", PanfactumProviderModel{StackVersion: types.StringValue("latest")}, path.Root("stack_version")},
+		{"stack_version date", PanfactumProviderModel{StackVersion: types.StringValue("edge.24-13-45")}, path.Root("stack_version")},
+		{"stack_commit", PanfactumProviderModel{StackCommit: types.StringValue("main")}, path.Root("stack_commit")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings := validateProviderModel(&test.model, false)
+			if warnings.HasError() || warnings.WarningsCount() != 1 {
+				t.Fatalf("expected exactly one warning, got %v", warnings)
+			}
+			if !warnings[0].(diag.DiagnosticWithPath).Path().Equal(test.path) {
+				t.Errorf("expected warning at %s, got %s", test.path, warnings[0].(diag.DiagnosticWithPath).Path())
+			}
+
+			errors := validateProviderModel(&test.model, true)
+			if errors.ErrorsCount() != 1 || errors.WarningsCount() != 0 {
+				t.Fatalf("expected exactly one error in strict mode, got %v", errors)
+			}
+		})
+	}
+}
+
+func TestValidateExtraTags(t *testing.T) {
+	t.Parallel()
+
+	extraTags := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"panfactum.com/team": types.StringValue("platform"),
+		"Cost Center":        types.StringValue("platform"),
+		"aws:owner":          types.StringValue("platform"),
+		"example.com/owner":  types.StringValue("owner@example.com"),
+		"unknown":            types.StringUnknown(),
+	})
+
+	expected := func(strict bool) diag.Diagnostics {
+		var diags diag.Diagnostics
+		addProviderValidationDiagnostic(&diags, path.Root("extra_tags").AtMapKey("Cost Center"), "Invalid extra tag key",
+			"The key 'Cost Center' will be changed to 'Cost.Center' in AWS tags and 'Cost.Center' in Kubernetes labels. Use a key that is valid as both an AWS tag key and a Kubernetes label key.",
+			strict,
+		)
+		addProviderValidationDiagnostic(&diags, path.Root("extra_tags").AtMapKey("aws:owner"), "Invalid extra tag key",
+			"The key 'aws:owner' will be changed to 'aws.owner' in Kubernetes labels. Use a key that is valid as both an AWS tag key and a Kubernetes label key.",
+			strict,
+		)
+		addProviderValidationDiagnostic(&diags, path.Root("extra_tags").AtMapKey("example.com/owner"), "Invalid extra tag value",
+			"The value 'owner@example.com' will be changed to 'owner.example.com' in Kubernetes labels. Use a value that is valid as both an AWS tag value and a Kubernetes label value.",
+			strict,
+		)
+		return diags
+	}
+
+	warnings := validateExtraTags(path.Root("extra_tags"), extraTags, false)
+	assertDiagnostics(t, "warnings", warnings, expected(false)...)
+	if warnings.HasError() {
+		t.Errorf("expected only warnings, got %v", warnings)
+	}
+
+	errors := validateExtraTags(path.Root("extra_tags"), extraTags, true)
+	assertDiagnostics(t, "errors", errors, expected(true)...)
+	if errors.WarningsCount() != 0 {
+		t.Errorf("expected only errors in strict mode, got %v", errors)
+	}
+
+	for _, extraTags := range []types.Map{types.MapNull(types.StringType), types.MapUnknown(types.StringType)} {
+		if diags := validateExtraTags(path.Root("extra_tags"), extraTags, true); len(diags) != 0 {
+			t.Errorf("expected no diagnostics for %s, got %v", extraTags, diags)
+		}
+	}
+}
+
+// extra_tags is validated with the rest of the provider configuration, before the provider is configured
+func TestValidateProviderConfig_ExtraTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	schemaResp := &provider.SchemaResponse{}
+	New().Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	server, err := providerserver.NewProtocol6WithError(New())()
+	if err != nil {
+		t.Fatalf("unable to create the provider server: %v", err)
+	}
+
+	for _, strict := range []bool{false, true} {
+		config, err := tfprotov6.NewDynamicValue(configType, testConfig(t, schemaResp.Schema.Type(), map[string]tftypes.Value{
+			"strict": tftypes.NewValue(tftypes.Bool, strict),
+			"extra_tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"Cost Center": tftypes.NewValue(tftypes.String, "platform"),
+			}),
+		}))
+		if err != nil {
+			t.Fatalf("unable to encode the provider configuration: %v", err)
+		}
+
+		resp, err := server.ValidateProviderConfig(ctx, &tfprotov6.ValidateProviderConfigRequest{Config: &config})
+		if err != nil {
+			t.Fatalf("unable to validate the provider configuration: %v", err)
+		}
+
+		expected := tfprotov6.DiagnosticSeverityWarning
+		if strict {
+			expected = tfprotov6.DiagnosticSeverityError
+		}
+		if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != expected || resp.Diagnostics[0].Summary != "Invalid extra tag key" {
+			t.Errorf("strict %t: expected one %s for the extra tag key, got %v", strict, expected, resp.Diagnostics)
+		}
+	}
+}
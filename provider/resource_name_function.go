@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	_ function.Function = ResourceNameFunction{}
+)
+
+func NewResourceNameFunction() function.Function {
+	return ResourceNameFunction{}
+}
+
+type ResourceNameFunction struct{}
+
+func (f ResourceNameFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "resource_name"
+}
+
+func (f ResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a name that is valid for the given AWS resource type",
+		Description: fmt.Sprintf("Joins the name parts with '-', applies the character set and length rules of the AWS resource type, and deterministically truncates overly long names with a stable hash suffix. Supported resource types: %s", strings.Join(resourceNameServices(), ", ")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The type of AWS resource that will be named",
+				Name:               "service",
+			},
+			function.ListParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The parts of the name to join",
+				Name:               "parts",
+				ElementType:        types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service string
+	var parts []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &service, &parts))
+	if resp.Error != nil {
+		return
+
+	}
+
+	name, err := resourceName(service, parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newFuncError(err, 0))
+		return
+	}
+
+	tflog.Debug(ctx, "Generated resource name", map[string]any{"service": service, "parts": parts, "name": name})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, name))
+}
+
+// resourceNameRule describes the naming constraints of a single AWS resource type
+type resourceNameRule struct {
+	minLength int
+	maxLength int
+
+	// invalidChars matches any character that is not allowed in the name
+	invalidChars *regexp.Regexp
+
+	lowercase            bool
+	startWithLetter      bool
+	alphanumericEnds     bool
+	noConsecutiveHyphens bool
+}
+
+const resourceNameHashLength = 6
+
+var resourceNameRules = map[string]resourceNameRule{
+	"iam_role": {
+		minLength:    1,
+		maxLength:    64,
+		invalidChars: regexp.MustCompile(`[^a-zA-Z0-9+=,.@_-]`),
+	},
+	"iam_policy": {
+		minLength:    1,
+		maxLength:    128,
+		invalidChars: regexp.MustCompile(`[^a-zA-Z0-9+=,.@_-]`),
+	},
+	"lb": {
+		minLength:        1,
+		maxLength:        32,
+		invalidChars:     regexp.MustCompile(`[^a-zA-Z0-9-]`),
+		alphanumericEnds: true,
+	},
+	"lb_target_group": {
+		minLength:        1,
+		maxLength:        32,
+		invalidChars:     regexp.MustCompile(`[^a-zA-Z0-9-]`),
+		alphanumericEnds: true,
+	},
+	"s3_bucket": {
+		minLength:        3,
+		maxLength:        63,
+		invalidChars:     regexp.MustCompile(`[^a-z0-9-]`),
+		lowercase:        true,
+		alphanumericEnds: true,
+	},
+	"rds": {
+		minLength:            1,
+		maxLength:            63,
+		invalidChars:         regexp.MustCompile(`[^a-z0-9-]`),
+		lowercase:            true,
+		startWithLetter:      true,
+		alphanumericEnds:     true,
+		noConsecutiveHyphens: true,
+	},
+	"elasticache": {
+		minLength:            1,
+		maxLength:            40,
+		invalidChars:         regexp.MustCompile(`[^a-z0-9-]`),
+		lowercase:            true,
+		startWithLetter:      true,
+		alphanumericEnds:     true,
+		noConsecutiveHyphens: true,
+	},
+	"lambda": {
+		minLength:    1,
+		maxLength:    64,
+		invalidChars: regexp.MustCompile(`[^a-zA-Z0-9_-]`),
+	},
+	"security_group": {
+		minLength:    1,
+		maxLength:    255,
+		invalidChars: regexp.MustCompile(`[^a-zA-Z0-9 ._:/()#,@\[\]+=&;{}!$*-]`),
+	},
+	"sqs_queue": {
+		minLength:    1,
+		maxLength:    80,
+		invalidChars: regexp.MustCompile(`[^a-zA-Z0-9_-]`),
+	},
+}
+
+var consecutiveHyphensRegex = regexp.MustCompile(`-{2,}`)
+
+func resourceNameServices() []string {
+	services := make([]string, 0, len(resourceNameRules))
+	for service := range resourceNameRules {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// resourceName joins the parts into a name that satisfies the rules of the given service:
+// 1. Joins non-empty parts with '-'
+// 2. Lowercases the name if required
+// 3. Replaces any invalid characters with '-'
+// 4. Collapses consecutive hyphens and trims non-alphanumeric ends if required
+// 5. Prefixes the name with 'x' if it must start with a letter
+// 6. Truncates the name and appends a hash of the untruncated name if it is too long
+func resourceName(service string, parts []string) (string, error) {
+	rule, ok := resourceNameRules[service]
+	if !ok {
+		return "", newArgumentError(0, "unsupported service '%s'; must be one of: %s", service, strings.Join(resourceNameServices(), ", "))
+	}
+
+	var nonEmptyParts []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmptyParts = append(nonEmptyParts, part)
+		}
+	}
+
+	name := strings.Join(nonEmptyParts, "-")
+	if rule.lowercase {
+		name = strings.ToLower(name)
+	}
+	name = rule.invalidChars.ReplaceAllString(name, "-")
+	name = rule.clean(name)
+
+	if len(name) > rule.maxLength {
+		suffix := hashSuffix(name, resourceNameHashLength)
+		name = strings.TrimRight(rule.clean(name[:rule.maxLength-len(suffix)-1]), "-") + "-" + suffix
+	}
+
+	if len(name) < rule.minLength {
+		return "", newArgumentError(1, "name '%s' generated from parts %q is shorter than the minimum length of %d for %s", name, parts, rule.minLength, service)
+	}
+
+	return name, nil
+}
+
+func (rule resourceNameRule) clean(name string) string {
+	if rule.noConsecutiveHyphens {
+		name = consecutiveHyphensRegex.ReplaceAllString(name, "-")
+	}
+	if rule.alphanumericEnds {
+		name = trimNonAlphanumeric(name)
+	}
+	if rule.startWithLetter && name != "" && !unicode.IsLetter(rune(name[0])) {
+		name = "x" + name
+	}
+	return name
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		service  string
+		parts    []string
+		expected string
+	}{
+		{"iam_role", []string{"production", "vault", "abc123"}, "production-vault-abc123"},
+		{"iam_role", []string{"prod", "", "role@x"}, "prod-role@x"},
+		{"s3_bucket", []string{"Production", "Logs_Bucket"}, "production-logs-bucket"},
+		{"s3_bucket", []string{"-logs-"}, "logs"},
+		{"rds", []string{"1st", "db"}, "x1st-db"},
+		{"rds", []string{"prod", "--", "db"}, "prod-db"},
+		{"elasticache", []string{"Prod", "Cache"}, "prod-cache"},
+		{"lb", []string{"prod", "ingress_nginx"}, "prod-ingress-nginx"},
+	}
+
+	for _, test := range tests {
+		actual, err := resourceName(test.service, test.parts)
+		if err != nil {
+			t.Errorf("%s %q: unexpected error: %v", test.service, test.parts, err)
+		} else if actual != test.expected {
+			t.Errorf("%s %q: expected %s, got %s", test.service, test.parts, test.expected, actual)
+		}
+	}
+}
+
+func TestResourceName_Truncation(t *testing.T) {
+	t.Parallel()
+
+	for service, rule := range resourceNameRules {
+		long := []string{"production", strings.Repeat("very-long-module-name", 20), "abc123"}
+		name, err := resourceName(service, long)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", service, err)
+		}
+		if len(name) > rule.maxLength {
+			t.Errorf("%s: expected length <= %d, got %d (%s)", service, rule.maxLength, len(name), name)
+		}
+		if rule.invalidChars.MatchString(name) {
+			t.Errorf("%s: name contains invalid characters: %s", service, name)
+		}
+
+		again, _ := resourceName(service, long)
+		if again != name {
+			t.Errorf("%s: truncation is not deterministic: %s != %s", service, name, again)
+		}
+
+		other, _ := resourceName(service, []string{"production", strings.Repeat("very-long-module-name", 20), "def456"})
+		if other == name {
+			t.Errorf("%s: names with different inputs collided: %s", service, name)
+		}
+	}
+}
+
+func TestResourceName_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := resourceName("not_a_service", []string{"a"}); err == nil {
+		t.Error("expected an error for an unsupported service")
+	}
+	if _, err := resourceName("s3_bucket", []string{"ab"}); err == nil {
+		t.Error("expected an error for a name below the minimum length")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"unicode/utf8"
+)
+
+/**************************************************************
+  Character Sets
+ **************************************************************/
+
+// sanitizeReplacement replaces every character that is not allowed by a sanitizeCharSet
+const sanitizeReplacement = '.'
+
+// sanitizeCharSet is a lookup table of the ASCII characters allowed in a sanitized value.
+// Letters and numbers are always allowed.
+type sanitizeCharSet [utf8.RuneSelf]bool
+
+func newSanitizeCharSet(extra string) *sanitizeCharSet {
+	var set sanitizeCharSet
+	for c := 0; c < utf8.RuneSelf; c++ {
+		set[c] = isASCIIAlphanumeric(byte(c))
+	}
+	for i := 0; i < len(extra); i++ {
+		set[extra[i]] = true
+	}
+	return &set
+}
+
+var (
+	awsTagCharSet         = newSanitizeCharSet(".:/_@+=-")
+	kubeLabelValueCharSet = newSanitizeCharSet("._-")
+	kubeLabelKeyCharSet   = newSanitizeCharSet("._/-")
+)
+
+/**************************************************************
+  Sanitization
+ **************************************************************/
+
+// replace returns the input with every rune that is not in the set replaced by
+// sanitizeReplacement. When trim is set, leading and trailing characters that are not
+// letters or numbers are removed. Inputs that are already sanitized are returned
+// without allocating.
+func (set *sanitizeCharSet) replace(input string, trim bool) string {
+	if set.isSanitized(input, trim) {
+		return input
+	}
+
+	buf := make([]byte, 0, len(input))
+	end := 0 // length of buf up to and including the last letter or number
+	for _, r := range input {
+		c := byte(sanitizeReplacement)
+		if r < utf8.RuneSelf && set[r] {
+			c = byte(r)
+		}
+		if isASCIIAlphanumeric(c) {
+			buf = append(buf, c)
+			end = len(buf)
+		} else if !trim || end > 0 {
+			buf = append(buf, c)
+		}
+	}
+
+	if trim {
+		buf = buf[:end]
+	}
+	return string(buf)
+}
+
+// isSanitized returns true if replace would return the input unchanged
+func (set *sanitizeCharSet) isSanitized(input string, trim bool) bool {
+	for i := 0; i < len(input); i++ {
+		if c := input[i]; c >= utf8.RuneSelf || !set[c] {
+			return false
+		}
+	}
+	return !trim || input == "" || (isASCIIAlphanumeric(input[0]) && isASCIIAlphanumeric(input[len(input)-1]))
+}
+
+// sanitizeDNSSubdomain lowercases the input and converts it to '.'-separated labels that each
+// start and end with a letter or number. Characters other than letters, numbers, '-' and '.'
+// separate labels, and empty labels are removed.
+func sanitizeDNSSubdomain(input string) string {
+	buf := make([]byte, 0, len(input))
+	end := 0 // length of buf up to and including the last letter or number
+	inLabel := false
+	for _, r := range input {
+		c := byte(sanitizeReplacement)
+		if r < utf8.RuneSelf {
+			c = toASCIILower(byte(r))
+		}
+		switch {
+		case isASCIIAlphanumeric(c):
+			if !inLabel {
+				buf = buf[:end]
+				if end > 0 {
+					buf = append(buf, '.')
+				}
+				inLabel = true
+			}
+			buf = append(buf, c)
+			end = len(buf)
+		case c == '-' && inLabel:
+			buf = append(buf, c)
+		default:
+			inLabel = false
+		}
+	}
+	return string(buf[:end])
+}
+
+func isASCIIAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func toASCIILower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+	return c
+}
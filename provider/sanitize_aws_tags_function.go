@@ -7,7 +7,7 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"regexp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
@@ -26,7 +26,8 @@ func (f SanitizeAWSTagsFunction) Metadata(_ context.Context, req function.Metada
 
 func (f SanitizeAWSTagsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
-		Summary: "Returns the AWS tags that have been sanitized of invalid characters",
+		Summary:     "Returns the AWS tags that have been sanitized of invalid characters",
+		Description: "Keys are truncated to 128 characters and values to 256 characters. Truncated strings end with a hash of the original string so that they remain unique and stable.",
 		Parameters: []function.Parameter{
 			function.MapParameter{
 				AllowNullValue:     false,
@@ -57,15 +58,24 @@ func (f SanitizeAWSTagsFunction) Run(ctx context.Context, req function.RunReques
 		sanitizedTags[sanitizeAWSTagKey(k)] = sanitizeAWSTagValue(v)
 	}
 
+	tflog.Debug(ctx, "Sanitized AWS tags", map[string]any{"tags": len(sanitizedTags)})
+
 	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sanitizedTags))
 }
 
+const (
+	awsTagKeyMaxLength   = 128
+	awsTagValueMaxLength = 256
+)
+
+// sanitizeAWSTagKey replaces any characters not allowed in AWS tag keys with '.' and
+// truncates the key to 128 characters, ending with a hash of the input if truncated
 func sanitizeAWSTagKey(input string) string {
-	re := regexp.MustCompile(`[^a-zA-Z0-9.:/_@+=-]`)
-	return re.ReplaceAllString(input, ".")
+	return truncateWithHash(awsTagCharSet.replace(input, false), awsTagKeyMaxLength, input)
 }
 
+// sanitizeAWSTagValue replaces any characters not allowed in AWS tag values with '.' and
+// truncates the value to 256 characters, ending with a hash of the input if truncated
 func sanitizeAWSTagValue(input string) string {
-	re := regexp.MustCompile(`[^a-zA-Z0-9.:/_@+=-]`)
-	return re.ReplaceAllString(input, ".")
+	return truncateWithHash(awsTagCharSet.replace(input, false), awsTagValueMaxLength, input)
 }
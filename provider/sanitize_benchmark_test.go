@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+/**************************************************************
+  Reference Implementations
+ **************************************************************/
+
+// The regexp-based sanitizers that the character set sanitizers replaced. The output of
+// the sanitizers must stay byte-for-byte identical to these.
+
+func referenceSanitizeAWSTag(input string, maxLength int) string {
+	re := regexp.MustCompile(`[^a-zA-Z0-9.:/_@+=-]`)
+	return truncateWithHash(re.ReplaceAllString(input, "."), maxLength, input)
+}
+
+func referenceSanitizeKubeLabelValue(input string) string {
+	re := regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+	sanitized := trimNonAlphanumeric(re.ReplaceAllString(input, "."))
+	return truncateWithHash(sanitized, kubeLabelNameMaxLength, input)
+}
+
+func referenceSanitizeKubeLabelKey(input string) string {
+	re := regexp.MustCompile(`[^a-zA-Z0-9._/-]`)
+	sanitized := trimNonAlphanumeric(re.ReplaceAllString(input, "."))
+
+	if index := strings.LastIndex(sanitized, "/"); index >= 0 {
+		name := truncateWithHash(trimNonAlphanumeric(sanitized[index+1:]), kubeLabelNameMaxLength, input)
+
+		prefixRe := regexp.MustCompile(`[^a-z0-9.-]`)
+		var labels []string
+		for _, label := range strings.Split(prefixRe.ReplaceAllString(strings.ToLower(sanitized[:index]), "."), ".") {
+			if label = trimNonAlphanumeric(label); label != "" {
+				labels = append(labels, label)
+			}
+		}
+		if len(labels) == 0 {
+			return name
+		}
+		return truncateWithHash(strings.Join(labels, "."), kubeLabelPrefixMaxLength, input) + "/" + name
+	}
+
+	return truncateWithHash(sanitized, kubeLabelNameMaxLength, input)
+}
+
+func assertSanitizersMatchReference(t *testing.T, input string) {
+	t.Helper()
+
+	if actual, expected := sanitizeAWSTagKey(input), referenceSanitizeAWSTag(input, awsTagKeyMaxLength); actual != expected {
+		t.Errorf("sanitizeAWSTagKey(%q) = %q, expected %q", input, actual, expected)
+	}
+	if actual, expected := sanitizeAWSTagValue(input), referenceSanitizeAWSTag(input, awsTagValueMaxLength); actual != expected {
+		t.Errorf("sanitizeAWSTagValue(%q) = %q, expected %q", input, actual, expected)
+	}
+	if actual, expected := sanitizeKubeLabelValue(input), referenceSanitizeKubeLabelValue(input); actual != expected {
+		t.Errorf("sanitizeKubeLabelValue(%q) = %q, expected %q", input, actual, expected)
+	}
+	if actual, expected := sanitizeKubeLabelKey(input), referenceSanitizeKubeLabelKey(input); actual != expected {
+		t.Errorf("sanitizeKubeLabelKey(%q) = %q, expected %q", input, actual, expected)
+	}
+}
+
+func TestSanitize_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	inputs := append([]string{
+		"\xff\xfe invalid utf-8",
+		"a-.-b/c",
+		"-a-/-b-",
+		"K/İ",
+	}, sanitizeFuzzSeeds...)
+	for _, input := range inputs {
+		assertSanitizersMatchReference(t, input)
+	}
+}
+
+func FuzzSanitize_MatchesReference(f *testing.F) {
+	for _, seed := range sanitizeFuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(assertSanitizersMatchReference)
+}
+
+/**************************************************************
+  Benchmarks
+ **************************************************************/
+
+// A typical set of tags, with both values that are already valid and values that are not
+var sanitizeBenchmarkInputs = []string{
+	"panfactum.com/module",
+	"panfactum.com/stack-version",
+	"edge.24-10-01",
+	"0123456789abcdef0123456789abcdef01234567",
+	"Cost Center",
+	"owner@example.com",
+	"example.com/" + strings.Repeat("name", 30),
+}
+
+func benchmarkSanitizer(b *testing.B, sanitize func(string) string) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, input := range sanitizeBenchmarkInputs {
+			sanitize(input)
+		}
+	}
+}
+
+func BenchmarkSanitizeAWSTagKey(b *testing.B) {
+	benchmarkSanitizer(b, sanitizeAWSTagKey)
+}
+
+func BenchmarkSanitizeAWSTagKey_Reference(b *testing.B) {
+	benchmarkSanitizer(b, func(input string) string { return referenceSanitizeAWSTag(input, awsTagKeyMaxLength) })
+}
+
+func BenchmarkSanitizeAWSTagValue(b *testing.B) {
+	benchmarkSanitizer(b, sanitizeAWSTagValue)
+}
+
+func BenchmarkSanitizeAWSTagValue_Reference(b *testing.B) {
+	benchmarkSanitizer(b, func(input string) string { return referenceSanitizeAWSTag(input, awsTagValueMaxLength) })
+}
+
+func BenchmarkSanitizeKubeLabelValue(b *testing.B) {
+	benchmarkSanitizer(b, sanitizeKubeLabelValue)
+}
+
+func BenchmarkSanitizeKubeLabelValue_Reference(b *testing.B) {
+	benchmarkSanitizer(b, referenceSanitizeKubeLabelValue)
+}
+
+func BenchmarkSanitizeKubeLabelKey(b *testing.B) {
+	benchmarkSanitizer(b, sanitizeKubeLabelKey)
+}
+
+func BenchmarkSanitizeKubeLabelKey_Reference(b *testing.B) {
+	benchmarkSanitizer(b, referenceSanitizeKubeLabelKey)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+var sanitizeFuzzSeeds = []string{
+	"",
+	"panfactum.com/module",
+	"app.kubernetes.io/name",
+	"Cost Center",
+	"owner@example.com",
+	"edge.24-10-01+local",
+	"-leading-and-trailing-",
+	"a//b",
+	"a./.b",
+	"Example.COM/name",
+	"under_score/name",
+	"ünïcode/日本",
+	strings.Repeat("prefix.", 50) + "/name",
+	"example.com/" + strings.Repeat("name", 30),
+}
+
+func FuzzSanitizeAWSTagKey(f *testing.F) {
+	for _, seed := range sanitizeFuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		key := sanitizeAWSTagKey(input)
+		if len(key) > awsTagKeyMaxLength || awsTagInvalidChars(key) {
+			t.Errorf("sanitizeAWSTagKey(%q) = %q is not a valid AWS tag key", input, key)
+		}
+		if again := sanitizeAWSTagKey(key); again != key {
+			t.Errorf("sanitizeAWSTagKey is not idempotent for %q: %q != %q", input, again, key)
+		}
+	})
+}
+
+func FuzzSanitizeAWSTagValue(f *testing.F) {
+	for _, seed := range sanitizeFuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		value := sanitizeAWSTagValue(input)
+		if len(value) > awsTagValueMaxLength || awsTagInvalidChars(value) {
+			t.Errorf("sanitizeAWSTagValue(%q) = %q is not a valid AWS tag value", input, value)
+		}
+		if again := sanitizeAWSTagValue(value); again != value {
+			t.Errorf("sanitizeAWSTagValue is not idempotent for %q: %q != %q", input, again, value)
+		}
+	})
+}
+
+func FuzzSanitizeKubeLabelValue(f *testing.F) {
+	for _, seed := range sanitizeFuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		value := sanitizeKubeLabelValue(input)
+		if !validKubeLabelName(value, true) {
+			t.Errorf("sanitizeKubeLabelValue(%q) = %q is not a valid Kubernetes label value", input, value)
+		}
+		if again := sanitizeKubeLabelValue(value); again != value {
+			t.Errorf("sanitizeKubeLabelValue is not idempotent for %q: %q != %q", input, again, value)
+		}
+	})
+}
+
+func FuzzSanitizeKubeLabelKey(f *testing.F) {
+	for _, seed := range sanitizeFuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		key := sanitizeKubeLabelKey(input)
+
+		// Only inputs without any letters or numbers sanitize to an empty key
+		if key == "" {
+			if strings.IndexFunc(input, func(r rune) bool { return r < 128 && isAlphanumeric(byte(r)) }) >= 0 {
+				t.Errorf("sanitizeKubeLabelKey(%q) is empty", input)
+			}
+			return
+		}
+		if !validKubeLabelKey(key) {
+			t.Errorf("sanitizeKubeLabelKey(%q) = %q is not a valid Kubernetes label key", input, key)
+		}
+		if again := sanitizeKubeLabelKey(key); again != key {
+			t.Errorf("sanitizeKubeLabelKey is not idempotent for %q: %q != %q", input, again, key)
+		}
+	})
+}
@@ -7,7 +7,7 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"regexp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
 	"unicode"
 )
@@ -28,7 +28,8 @@ func (f SanitizeKubeLabelsFunction) Metadata(_ context.Context, req function.Met
 
 func (f SanitizeKubeLabelsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
-		Summary: "Returns the Kubernetes labels that have been sanitized of invalid characters",
+		Summary:     "Returns the Kubernetes labels that have been sanitized of invalid characters",
+		Description: "Keys and values are truncated to the Kubernetes length limits (63 characters for values and key names, 253 for key prefixes). Truncated strings end with a hash of the original string so that they remain unique and stable.",
 		Parameters: []function.Parameter{
 			function.MapParameter{
 				AllowNullValue:     false,
@@ -59,37 +60,54 @@ func (f SanitizeKubeLabelsFunction) Run(ctx context.Context, req function.RunReq
 		sanitizedLabels[sanitizeKubeLabelKey(k)] = sanitizeKubeLabelValue(v)
 	}
 
+	tflog.Debug(ctx, "Sanitized Kubernetes labels", map[string]any{"labels": len(sanitizedLabels)})
+
 	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sanitizedLabels))
 }
 
+const (
+	kubeLabelNameMaxLength   = 63
+	kubeLabelPrefixMaxLength = 253
+)
+
 // sanitizeKubeLabelValue performs the required sanitization steps:
 // 1. Replaces any non-alphanumeric, '.', '_', or '-' characters with '.'
 // 2. Ensures the string starts and ends with an alphanumeric character
+// 3. Truncates the string to 63 characters, ending with a hash of the input if truncated
 func sanitizeKubeLabelValue(input string) string {
-	// Replace any non-alphanumeric, '.', '_', or '-' characters with '.'
-	re := regexp.MustCompile(`[^a-zA-Z0-9._-]`)
-	sanitized := re.ReplaceAllString(input, ".")
-
-	// Trim any leading or trailing non-alphanumeric characters
-	sanitized = strings.TrimFunc(sanitized, func(r rune) bool {
-		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
-	})
+	// Replace any non-alphanumeric, '.', '_', or '-' characters with '.' and trim any
+	// leading or trailing non-alphanumeric characters
+	sanitized := kubeLabelValueCharSet.replace(input, true)
 
-	return sanitized
+	return truncateWithHash(sanitized, kubeLabelNameMaxLength, input)
 }
 
 // sanitizeKubeLabelKey performs the required sanitization steps:
 // 1. Replaces any non-alphanumeric, '.', '_', '-', or '/' characters with '.'
 // 2. Ensures the string starts and ends with an alphanumeric character
+// 3. Sanitizes the prefix (before the last '/') to a DNS subdomain and the name to a label value
+// 4. Truncates the name to 63 characters and the prefix to 253 characters,
+// ending each with a hash of the input if truncated
 func sanitizeKubeLabelKey(input string) string {
-	// Replace any non-alphanumeric, '.', '_', '-', or '/' characters with '.'
-	re := regexp.MustCompile(`[^a-zA-Z0-9._/-]`)
-	sanitized := re.ReplaceAllString(input, ".")
+	// Replace any non-alphanumeric, '.', '_', '-', or '/' characters with '.' and trim any
+	// leading or trailing non-alphanumeric characters
+	sanitized := kubeLabelKeyCharSet.replace(input, true)
+
+	if index := strings.LastIndex(sanitized, "/"); index >= 0 {
+		name := truncateWithHash(trimNonAlphanumeric(sanitized[index+1:]), kubeLabelNameMaxLength, input)
+		prefix := sanitizeDNSSubdomain(sanitized[:index])
+		if prefix == "" {
+			return name
+		}
+		return truncateWithHash(prefix, kubeLabelPrefixMaxLength, input) + "/" + name
+	}
 
-	// Trim any leading or trailing non-alphanumeric characters
-	sanitized = strings.TrimFunc(sanitized, func(r rune) bool {
+	return truncateWithHash(sanitized, kubeLabelNameMaxLength, input)
+}
+
+// trimNonAlphanumeric removes any leading or trailing characters that are not letters or numbers
+func trimNonAlphanumeric(input string) string {
+	return strings.TrimFunc(input, func(r rune) bool {
 		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
 	})
-
-	return sanitized
 }
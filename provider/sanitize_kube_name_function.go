@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	_ function.Function = SanitizeKubeNameFunction{}
+)
+
+func NewSanitizeKubeNameFunction() function.Function {
+	return SanitizeKubeNameFunction{}
+}
+
+type SanitizeKubeNameFunction struct{}
+
+func (f SanitizeKubeNameFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sanitize_kube_name"
+}
+
+func (f SanitizeKubeNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a Kubernetes object name that has been sanitized according to the naming rules of the object kind",
+		Description: fmt.Sprintf("Lowercases the name, replaces invalid characters, and deterministically truncates overly long names with a stable hash suffix. Supported kinds: %s", strings.Join(kubeNameKinds(), ", ")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The name to sanitize",
+				Name:               "name",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The kind of the Kubernetes object (e.g., Deployment) or the name of the naming rule (e.g., dns_1123_label)",
+				Name:               "kind",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f SanitizeKubeNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, kind string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &kind))
+	if resp.Error != nil {
+		return
+
+	}
+
+	rule, err := getKubeNameRule(kind)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	sanitized := rule.sanitize(name)
+	if sanitized == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Name '%s' does not contain any valid characters\n", name)))
+		return
+	}
+
+	tflog.Debug(ctx, "Sanitized Kubernetes name", map[string]any{"name": name, "kind": kind, "sanitized": sanitized})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, sanitized))
+}
+
+// kubeNameRule is one of the object naming conventions used by Kubernetes
+// (https://kubernetes.io/docs/concepts/overview/working-with-objects/names/)
+type kubeNameRule struct {
+	maxLength       int
+	allowDots       bool
+	startWithLetter bool
+	valid           *regexp.Regexp
+}
+
+const kubeNameHashLength = 6
+
+var (
+	kubeNameDNS1123Label = kubeNameRule{
+		maxLength: 63,
+		valid:     regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`),
+	}
+	kubeNameDNS1123Subdomain = kubeNameRule{
+		maxLength: 253,
+		allowDots: true,
+		valid:     regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`),
+	}
+	kubeNameDNS1035Label = kubeNameRule{
+		maxLength:       63,
+		startWithLetter: true,
+		valid:           regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`),
+	}
+
+	// The CronJob controller appends an 11 character suffix to the names of the Jobs that
+	// it creates, which must still be DNS-1123 labels
+	kubeNameCronJob = kubeNameRule{
+		maxLength: 52,
+		valid:     kubeNameDNS1123Label.valid,
+	}
+)
+
+var kubeNameRules = map[string]kubeNameRule{
+	"dns_1123_label":        kubeNameDNS1123Label,
+	"dns_1123_subdomain":    kubeNameDNS1123Subdomain,
+	"dns_1035_label":        kubeNameDNS1035Label,
+	"namespace":             kubeNameDNS1123Label,
+	"service":               kubeNameDNS1035Label,
+	"deployment":            kubeNameDNS1123Subdomain,
+	"statefulset":           kubeNameDNS1123Subdomain,
+	"daemonset":             kubeNameDNS1123Subdomain,
+	"pod":                   kubeNameDNS1123Subdomain,
+	"configmap":             kubeNameDNS1123Subdomain,
+	"secret":                kubeNameDNS1123Subdomain,
+	"serviceaccount":        kubeNameDNS1123Subdomain,
+	"persistentvolumeclaim": kubeNameDNS1123Subdomain,
+	"ingress":               kubeNameDNS1123Subdomain,
+	"job":                   kubeNameDNS1123Label,
+	"cronjob":               kubeNameCronJob,
+}
+
+var (
+	kubeNameInvalidCharsRegex = regexp.MustCompile(`[^a-z0-9.-]`)
+	kubeNameDotsRegex         = regexp.MustCompile(`\.{2,}`)
+)
+
+func kubeNameKinds() []string {
+	kinds := make([]string, 0, len(kubeNameRules))
+	for kind := range kubeNameRules {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// getKubeNameRule returns the naming rule for the kind, ignoring case
+func getKubeNameRule(kind string) (kubeNameRule, error) {
+	rule, ok := kubeNameRules[strings.ToLower(kind)]
+	if !ok {
+		return kubeNameRule{}, fmt.Errorf("unsupported kind '%s'; must be one of: %s", kind, strings.Join(kubeNameKinds(), ", "))
+	}
+	return rule, nil
+}
+
+// sanitize performs the required sanitization steps:
+// 1. Lowercases the name
+// 2. Replaces any characters that are not alphanumeric, '-', or '.' (if allowed) with '-'
+// 3. Ensures every dot-separated segment starts and ends with an alphanumeric character
+// 4. Prefixes the name with 'x' if it must start with a letter
+// 5. Truncates the name and appends a hash of the untruncated name if it is too long
+func (rule kubeNameRule) sanitize(input string) string {
+	sanitized := kubeNameInvalidCharsRegex.ReplaceAllString(strings.ToLower(input), "-")
+	sanitized = rule.clean(sanitized)
+
+	if len(sanitized) > rule.maxLength {
+		suffix := hashSuffix(sanitized, kubeNameHashLength)
+		sanitized = rule.clean(sanitized[:rule.maxLength-len(suffix)-1]) + "-" + suffix
+	}
+
+	return sanitized
+}
+
+func (rule kubeNameRule) clean(input string) string {
+	if !rule.allowDots {
+		input = strings.ReplaceAll(input, ".", "-")
+	}
+	input = kubeNameDotsRegex.ReplaceAllString(input, ".")
+
+	segments := strings.Split(input, ".")
+	cleaned := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if segment = trimNonAlphanumeric(segment); segment != "" {
+			cleaned = append(cleaned, segment)
+		}
+	}
+	output := strings.Join(cleaned, ".")
+
+	if rule.startWithLetter && output != "" && (output[0] < 'a' || output[0] > 'z') {
+		output = "x" + output
+	}
+	return output
+}
+
+func (rule kubeNameRule) validate(name string) bool {
+	return len(name) <= rule.maxLength && rule.valid.MatchString(name)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeKubeName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		kind     string
+		expected string
+	}{
+		{"My_Deployment", "Deployment", "my-deployment"},
+		{"app.v1..Config", "ConfigMap", "app.v1.config"},
+		{"-.leading.and.trailing.-", "configmap", "leading.and.trailing"},
+		{"app.v1", "namespace", "app-v1"},
+		{"123-api", "Service", "x123-api"},
+		{"123-api", "dns_1123_label", "123-api"},
+		{"already-valid", "service", "already-valid"},
+	}
+
+	for _, test := range tests {
+		rule, err := getKubeNameRule(test.kind)
+		if err != nil {
+			t.Fatalf("unexpected error for kind %s: %v", test.kind, err)
+		}
+		if actual := rule.sanitize(test.name); actual != test.expected {
+			t.Errorf("sanitize(%s, %s): expected %s, got %s", test.name, test.kind, test.expected, actual)
+		}
+	}
+}
+
+func TestSanitizeKubeName_AlwaysValid(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		strings.Repeat("Very_Long.Name-", 30),
+		"---",
+		"9.9.9",
+		"ÜNICODE-näme",
+		strings.Repeat("a", 62) + "-.b",
+	}
+
+	for kind, rule := range kubeNameRules {
+		for _, input := range inputs {
+			sanitized := rule.sanitize(input)
+			if sanitized != "" && !rule.validate(sanitized) {
+				t.Errorf("sanitize(%q, %s) produced invalid name %q", input, kind, sanitized)
+			}
+			if rule.sanitize(sanitized) != sanitized {
+				t.Errorf("sanitize(%q, %s) is not idempotent", input, kind)
+			}
+		}
+	}
+}
+
+func TestValidateKubeName(t *testing.T) {
+	t.Parallel()
+
+	service, _ := getKubeNameRule("service")
+	configMap, _ := getKubeNameRule("configmap")
+
+	if service.validate("1-api") {
+		t.Error("service names must start with a letter")
+	}
+	if service.validate("api.v1") {
+		t.Error("service names must not contain dots")
+	}
+	if !configMap.validate("api.v1") {
+		t.Error("configmap names may contain dots")
+	}
+	if configMap.validate(strings.Repeat("a", 254)) {
+		t.Error("configmap names must be at most 253 characters")
+	}
+	cronJob, _ := getKubeNameRule("CronJob")
+	if !cronJob.validate(strings.Repeat("a", 52)) {
+		t.Error("cronjob names may be 52 characters")
+	}
+	if cronJob.validate(strings.Repeat("a", 53)) {
+		t.Error("cronjob names must be at most 52 characters")
+	}
+	if sanitized := cronJob.sanitize(strings.Repeat("a", 53)); len(sanitized) != 52 {
+		t.Errorf("cronjob names must be truncated to 52 characters, got %q", sanitized)
+	}
+	if _, err := getKubeNameRule("widget"); err == nil {
+		t.Error("expected an error for an unsupported kind")
+	}
+}
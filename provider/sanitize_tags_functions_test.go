@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestSanitizeTagsFunctions(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                locals {
+                    tags = {
+                        "Cost Center"       = "platform team"
+                        "example.com/owner" = "owner@example.com"
+                    }
+                }
+
+                output "aws_tags" {
+                    value = provider::pf::sanitize_aws_tags(local.tags)
+                }
+
+                output "kube_labels" {
+                    value = provider::pf::sanitize_kube_labels(local.tags)
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("aws_tags", knownvalue.MapExact(map[string]knownvalue.Check{
+						"Cost.Center":       knownvalue.StringExact("platform.team"),
+						"example.com/owner": knownvalue.StringExact("owner@example.com"),
+					})),
+					statecheck.ExpectKnownOutputValue("kube_labels", knownvalue.MapExact(map[string]knownvalue.Check{
+						"Cost.Center":       knownvalue.StringExact("platform.team"),
+						"example.com/owner": knownvalue.StringExact("owner.example.com"),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeAWSTagKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"panfactum.com/module", "panfactum.com/module"},
+		{"aws:cloudformation:stack-name", "aws:cloudformation:stack-name"},
+		{"Cost Center", "Cost.Center"},
+		{"team(platform)", "team.platform."},
+		{"a,b;c", "a.b.c"},
+		{"key_with@all+allowed=chars-1.2", "key_with@all+allowed=chars-1.2"},
+		{"ünïcode", ".n.code"},
+		{"日本", ".."},
+	}
+
+	for _, test := range tests {
+		if actual := sanitizeAWSTagKey(test.input); actual != test.expected {
+			t.Errorf("sanitizeAWSTagKey(%q) = %q, expected %q", test.input, actual, test.expected)
+		}
+	}
+}
+
+func TestSanitizeAWSTagValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"production", "production"},
+		{"edge.24-10-01", "edge.24-10-01"},
+		{"hello world", "hello.world"},
+		{"owner@example.com", "owner@example.com"},
+		{"a=b+c", "a=b+c"},
+		{"line\nbreak", "line.break"},
+		{"<null>", ".null."},
+	}
+
+	for _, test := range tests {
+		if actual := sanitizeAWSTagValue(test.input); actual != test.expected {
+			t.Errorf("sanitizeAWSTagValue(%q) = %q, expected %q", test.input, actual, test.expected)
+		}
+	}
+}
+
+func TestSanitizeKubeLabelValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"production", "production"},
+		{"edge.24-10-01", "edge.24-10-01"},
+		{"hello world", "hello.world"},
+		{"-leading-and-trailing-", "leading-and-trailing"},
+		{"..dots..", "dots"},
+		{"a/b:c", "a.b.c"},
+		{"owner@example.com", "owner.example.com"},
+		{"___", ""},
+		{"ünï", "n"},
+	}
+
+	for _, test := range tests {
+		if actual := sanitizeKubeLabelValue(test.input); actual != test.expected {
+			t.Errorf("sanitizeKubeLabelValue(%q) = %q, expected %q", test.input, actual, test.expected)
+		}
+	}
+}
+
+func TestSanitizeKubeLabelKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"app", "app"},
+		{"panfactum.com/module", "panfactum.com/module"},
+		{"app.kubernetes.io/name", "app.kubernetes.io/name"},
+		{"example.com/my label", "example.com/my.label"},
+		{"/leading-slash", "leading-slash"},
+		{"trailing-slash/", "trailing-slash"},
+		{"Cost Center", "Cost.Center"},
+		{"team:owner", "team.owner"},
+		// The prefix must be a lowercase DNS subdomain
+		{"Example.COM/name", "example.com/name"},
+		{"under_score/name", "under.score/name"},
+		{"a//b", "a/b"},
+		{"a./.b", "a/b"},
+		{"../name", "name"},
+	}
+
+	for _, test := range tests {
+		if actual := sanitizeKubeLabelKey(test.input); actual != test.expected {
+			t.Errorf("sanitizeKubeLabelKey(%q) = %q, expected %q", test.input, actual, test.expected)
+		}
+	}
+}
+
+// The sanitized values must always satisfy the constraints that they sanitize for
+func TestSanitize_AlwaysValid(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		"",
+		"simple",
+		"  spaces everywhere  ",
+		"ünïcode-ünïcode",
+		"example.com/" + strings.Repeat("name", 30),
+		strings.Repeat("prefix.", 50) + "/name",
+		strings.Repeat("x", 300),
+		"-._-",
+	}
+
+	for _, input := range inputs {
+		if key := sanitizeAWSTagKey(input); len(key) > awsTagKeyMaxLength || awsTagInvalidChars(key) {
+			t.Errorf("sanitizeAWSTagKey(%q) = %q is not a valid AWS tag key", input, key)
+		}
+		if value := sanitizeAWSTagValue(input); len(value) > awsTagValueMaxLength || awsTagInvalidChars(value) {
+			t.Errorf("sanitizeAWSTagValue(%q) = %q is not a valid AWS tag value", input, value)
+		}
+		if value := sanitizeKubeLabelValue(input); !validKubeLabelName(value, true) {
+			t.Errorf("sanitizeKubeLabelValue(%q) = %q is not a valid Kubernetes label value", input, value)
+		}
+		if key := sanitizeKubeLabelKey(input); key != "" && !validKubeLabelKey(key) {
+			t.Errorf("sanitizeKubeLabelKey(%q) = %q is not a valid Kubernetes label key", input, key)
+		}
+	}
+}
+
+func awsTagInvalidChars(value string) bool {
+	return strings.IndexFunc(value, func(r rune) bool {
+		return !strings.ContainsRune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789.:/_@+=-", r)
+	}) >= 0
+}
+
+// validKubeLabelName checks a label value or the name segment of a label key
+func validKubeLabelName(value string, allowEmpty bool) bool {
+	if value == "" {
+		return allowEmpty
+	}
+	if len(value) > kubeLabelNameMaxLength || !isAlphanumeric(value[0]) || !isAlphanumeric(value[len(value)-1]) {
+		return false
+	}
+	return strings.IndexFunc(value, func(r rune) bool {
+		return r > 127 || !(isAlphanumeric(byte(r)) || r == '.' || r == '_' || r == '-')
+	}) < 0
+}
+
+// validKubeLabelKey checks a label key, whose optional prefix must be a DNS subdomain
+func validKubeLabelKey(key string) bool {
+	prefix, name, hasPrefix := strings.Cut(key, "/")
+	if !hasPrefix {
+		return validKubeLabelName(key, false)
+	}
+	return len(prefix) <= kubeLabelPrefixMaxLength && kubeLabelPrefixPattern.MatchString(prefix) && validKubeLabelName(name, false)
+}
+
+var kubeLabelPrefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+func isAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
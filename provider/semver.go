@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/**************************************************************
+  Semantic Versions
+ **************************************************************/
+
+type semver struct {
+	major, minor, patch *big.Int
+	prerelease          []string
+}
+
+var (
+	semverRegex        = regexp.MustCompile(`^v?(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)(?:-((?:0|[1-9][0-9]*|[0-9]*[A-Za-z-][0-9A-Za-z-]*)(?:\.(?:0|[1-9][0-9]*|[0-9]*[A-Za-z-][0-9A-Za-z-]*))*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+	semverPartialRegex = regexp.MustCompile(`^v?(0|[1-9][0-9]*)(?:\.(0|[1-9][0-9]*))?(?:\.(0|[1-9][0-9]*))?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+	semverNumericRegex = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// parseSemver parses a SemVer 2.0.0 version with an optional leading 'v'
+func parseSemver(input string) (*semver, error) {
+	match := semverRegex.FindStringSubmatch(input)
+	if match == nil {
+		return nil, fmt.Errorf("'%s' is not a valid semantic version", input)
+	}
+	return newSemver(match[1], match[2], match[3], match[4]), nil
+}
+
+func newSemver(major, minor, patch, prerelease string) *semver {
+	version := semver{
+		major: parseSemverNumber(major),
+		minor: parseSemverNumber(minor),
+		patch: parseSemverNumber(patch),
+	}
+	if prerelease != "" {
+		version.prerelease = strings.Split(prerelease, ".")
+	}
+	return &version
+}
+
+func parseSemverNumber(input string) *big.Int {
+	value, ok := new(big.Int).SetString(input, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return value
+}
+
+// compare returns -1, 0, or 1 according to SemVer 2.0.0 precedence. Build metadata is ignored.
+func (v *semver) compare(other *semver) int {
+	if c := v.major.Cmp(other.major); c != 0 {
+		return c
+	}
+	if c := v.minor.Cmp(other.minor); c != 0 {
+		return c
+	}
+	if c := v.patch.Cmp(other.patch); c != 0 {
+		return c
+	}
+
+	// A version without a prerelease has higher precedence than one with a prerelease
+	switch {
+	case len(v.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		if c := compareSemverIdentifier(v.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(v.prerelease), len(other.prerelease))
+}
+
+// compareSemverIdentifier compares prerelease identifiers: numeric identifiers are compared
+// numerically and have lower precedence than alphanumeric identifiers, which are compared lexically
+func compareSemverIdentifier(a, b string) int {
+	aNumeric, bNumeric := semverNumericRegex.MatchString(a), semverNumericRegex.MatchString(b)
+	switch {
+	case aNumeric && bNumeric:
+		return parseSemverNumber(a).Cmp(parseSemverNumber(b))
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+/**************************************************************
+  Version Constraints
+ **************************************************************/
+
+// semverConstraint is a single operator and version (e.g., '>= 1.2.0')
+type semverConstraint struct {
+	operator string
+	version  *semver
+
+	// parts is the number of version components that were specified (e.g., 2 for '~> 1.2')
+	parts int
+}
+
+var semverConstraintRegex = regexp.MustCompile(`^(=|!=|>=|<=|>|<|~>|\^|~)?\s*(\S+)$`)
+
+// parseSemverConstraints parses a comma-separated list of constraints that must all be satisfied.
+// Supported operators: =, !=, >, >=, <, <=, ~> (pessimistic), ^ (compatible), and ~ (patch-level).
+// Missing minor and patch components in constraint versions default to 0.
+func parseSemverConstraints(input string) ([]semverConstraint, error) {
+	var constraints []semverConstraint
+
+	for _, part := range strings.Split(input, ",") {
+		match := semverConstraintRegex.FindStringSubmatch(strings.TrimSpace(part))
+		if match == nil {
+			return nil, fmt.Errorf("'%s' is not a valid version constraint", strings.TrimSpace(part))
+		}
+
+		versionMatch := semverPartialRegex.FindStringSubmatch(match[2])
+		if versionMatch == nil {
+			return nil, fmt.Errorf("'%s' in constraint '%s' is not a valid version", match[2], strings.TrimSpace(part))
+		}
+
+		parts := 1
+		minor, patch := "0", "0"
+		if versionMatch[2] != "" {
+			minor = versionMatch[2]
+			parts++
+		}
+		if versionMatch[3] != "" {
+			patch = versionMatch[3]
+			parts++
+		}
+
+		operator := match[1]
+		if operator == "" {
+			operator = "="
+		}
+
+		constraints = append(constraints, semverConstraint{
+			operator: operator,
+			version:  newSemver(versionMatch[1], minor, patch, versionMatch[4]),
+			parts:    parts,
+		})
+	}
+
+	return constraints, nil
+}
+
+func (c semverConstraint) satisfiedBy(version *semver) bool {
+	cmp := version.compare(c.version)
+
+	switch c.operator {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case "~>":
+		// Allows the right-most specified component to increase (e.g., ~> 1.2 is >= 1.2.0, < 2.0.0)
+		if c.parts == 1 {
+			return cmp >= 0 && version.major.Cmp(c.version.major) == 0
+		}
+		return cmp >= 0 && version.compare(c.bumpedUpperBound(c.parts-1)) < 0
+	case "^":
+		// Allows changes that do not modify the left-most non-zero component
+		switch {
+		case c.version.major.Sign() != 0 || c.parts == 1:
+			return cmp >= 0 && version.compare(c.bumpedUpperBound(1)) < 0
+		case c.version.minor.Sign() != 0 || c.parts == 2:
+			return cmp >= 0 && version.compare(c.bumpedUpperBound(2)) < 0
+		default:
+			return cmp >= 0 && version.compare(c.bumpedUpperBound(3)) < 0
+		}
+	case "~":
+		// Allows patch-level changes if a minor version is specified, and minor-level changes if not
+		if c.parts == 1 {
+			return cmp >= 0 && version.compare(c.bumpedUpperBound(1)) < 0
+		}
+		return cmp >= 0 && version.compare(c.bumpedUpperBound(2)) < 0
+	default:
+		return false
+	}
+}
+
+// bumpedUpperBound returns the exclusive upper bound created by incrementing the given
+// component (1 = major, 2 = minor, 3 = patch) of the constraint version. The bound has a
+// minimal prerelease so that prereleases of the next version are also excluded.
+func (c semverConstraint) bumpedUpperBound(component int) *semver {
+	one := big.NewInt(1)
+	bound := semver{major: new(big.Int).Set(c.version.major), minor: new(big.Int), patch: new(big.Int), prerelease: []string{"0"}}
+	switch component {
+	case 1:
+		bound.major.Add(bound.major, one)
+	case 2:
+		bound.minor.Add(c.version.minor, one)
+	default:
+		bound.minor.Set(c.version.minor)
+		bound.patch.Add(c.version.patch, one)
+	}
+	return &bound
+}
+
+/**************************************************************
+  Panfactum Stack Versions
+ **************************************************************/
+
+// stackVersion is a Panfactum Stack version in one of the release formats:
+//
+//	edge.YY-MM-DD      an edge release cut on the given day
+//	stable.YY-MM.N     the Nth patch of the stable release branched in the given month ('stable.' is optional)
+//	edge, main, local  the unreleased head of the stack, which is newer than every release
+type stackVersion struct {
+	year, month, day int
+	stable           bool
+	patch            int
+	head             bool
+}
+
+var (
+	stackVersionEdgeRegex   = regexp.MustCompile(`^edge\.([0-9]{2})-([0-9]{2})-([0-9]{2})$`)
+	stackVersionStableRegex = regexp.MustCompile(`^(?:stable\.)?([0-9]{2})-([0-9]{2})\.([0-9]+)$`)
+)
+
+func parseStackVersion(input string) (*stackVersion, error) {
+	switch input {
+	case "edge", "main", "local":
+		return &stackVersion{head: true}, nil
+	}
+
+	if match := stackVersionEdgeRegex.FindStringSubmatch(input); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		month, _ := strconv.Atoi(match[2])
+		day, _ := strconv.Atoi(match[3])
+		if month < 1 || month > 12 || day < 1 || day > 31 {
+			return nil, fmt.Errorf("'%s' does not contain a valid date", input)
+		}
+		return &stackVersion{year: year, month: month, day: day}, nil
+	}
+
+	if match := stackVersionStableRegex.FindStringSubmatch(input); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		month, _ := strconv.Atoi(match[2])
+		patch, err := strconv.Atoi(match[3])
+		if err != nil || month < 1 || month > 12 {
+			return nil, fmt.Errorf("'%s' does not contain a valid release", input)
+		}
+		return &stackVersion{year: year, month: month, stable: true, patch: patch}, nil
+	}
+
+	return nil, fmt.Errorf("'%s' is not a Panfactum Stack version (e.g., edge.24-10-01, stable.24-10.1, or 24-10.1)", input)
+}
+
+// compare orders stack versions chronologically. Stable releases are branched from edge at
+// the start of their month, so they sort after every edge release of the previous month and
+// before every edge release of their own month.
+func (v *stackVersion) compare(other *stackVersion) int {
+	switch {
+	case v.head && other.head:
+		return 0
+	case v.head:
+		return 1
+	case other.head:
+		return -1
+	}
+
+	if c := compareInts(v.year, other.year); c != 0 {
+		return c
+	}
+	if c := compareInts(v.month, other.month); c != 0 {
+		return c
+	}
+	switch {
+	case v.stable && other.stable:
+		return compareInts(v.patch, other.patch)
+	case v.stable:
+		return -1
+	case other.stable:
+		return 1
+	default:
+		return compareInts(v.day, other.day)
+	}
+}
+
+// compareVersions compares two versions that are either both Panfactum Stack versions or both
+// semantic versions
+func compareVersions(a, b string) (int, error) {
+	aStack, aErr := parseStackVersion(a)
+	bStack, bErr := parseStackVersion(b)
+	if aErr == nil && bErr == nil {
+		return aStack.compare(bStack), nil
+	}
+
+	aSemver, aSemverErr := parseSemver(a)
+	bSemver, bSemverErr := parseSemver(b)
+	if aSemverErr == nil && bSemverErr == nil {
+		return aSemver.compare(bSemver), nil
+	}
+
+	switch {
+	case aErr != nil && aSemverErr != nil:
+		return 0, newArgumentError(0, "'%s' is neither a Panfactum Stack version nor a semantic version", a)
+	case bErr != nil && bSemverErr != nil:
+		return 0, newArgumentError(1, "'%s' is neither a Panfactum Stack version nor a semantic version", b)
+	default:
+		return 0, fmt.Errorf("cannot compare '%s' and '%s' as one is a Panfactum Stack version and the other is a semantic version", a, b)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = SemverCompareFunction{}
+)
+
+func NewSemverCompareFunction() function.Function {
+	return SemverCompareFunction{}
+}
+
+type SemverCompareFunction struct{}
+
+func (f SemverCompareFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_compare"
+}
+
+func (f SemverCompareFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compares two semantic versions",
+		Description: "Returns -1 if a is lower than b, 0 if they have the same precedence, and 1 if a is higher than b according to SemVer 2.0.0. A leading 'v' is allowed and build metadata is ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The first version (e.g., 1.2.3)",
+				Name:               "a",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The second version (e.g., v1.3.0-rc.1)",
+				Name:               "b",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f SemverCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var aStr, bStr string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &aStr, &bStr))
+	if resp.Error != nil {
+		return
+
+	}
+
+	a, err := parseSemver(aStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	b, err := parseSemver(bStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	result := int64(a.compare(b))
+	tflog.Debug(ctx, "Compared semantic versions", map[string]any{"a": aStr, "b": bStr, "result": result})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = SemverSatisfiesFunction{}
+)
+
+func NewSemverSatisfiesFunction() function.Function {
+	return SemverSatisfiesFunction{}
+}
+
+type SemverSatisfiesFunction struct{}
+
+func (f SemverSatisfiesFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_satisfies"
+}
+
+func (f SemverSatisfiesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns true if a semantic version satisfies a version constraint",
+		Description: "The constraint is a comma-separated list of conditions that must all hold (e.g., '>= 1.2.0, < 2.0.0'). Supported operators: =, !=, >, >=, <, <=, ~> (e.g., '~> 1.2' allows >= 1.2.0 and < 2.0.0), ^ (e.g., '^0.3.1' allows >= 0.3.1 and < 0.4.0), and ~ (e.g., '~1.2.3' allows >= 1.2.3 and < 1.3.0).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The version to check (e.g., 1.2.3)",
+				Name:               "version",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The version constraint (e.g., >= 1.2.0, < 2.0.0)",
+				Name:               "constraint",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f SemverSatisfiesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var versionStr, constraintStr string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &versionStr, &constraintStr))
+	if resp.Error != nil {
+		return
+
+	}
+
+	version, err := parseSemver(versionStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	constraints, err := parseSemverConstraints(constraintStr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	satisfied := true
+	for _, constraint := range constraints {
+		if !constraint.satisfiedBy(version) {
+			satisfied = false
+			break
+		}
+	}
+
+	tflog.Debug(ctx, "Checked semantic version constraint", map[string]any{
+		"version":    versionStr,
+		"constraint": constraintStr,
+		"satisfied":  satisfied,
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, satisfied))
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestSemverCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"1.2.3+build.1", "1.2.3+build.2", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"2.0.0", "10.0.0", -1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"99999999999999999999.0.0", "1.0.0", 1},
+	}
+
+	for _, test := range tests {
+		a, err := parseSemver(test.a)
+		if err != nil {
+			t.Fatalf("parseSemver(%q) returned error: %v", test.a, err)
+		}
+		b, err := parseSemver(test.b)
+		if err != nil {
+			t.Fatalf("parseSemver(%q) returned error: %v", test.b, err)
+		}
+		if result := a.compare(b); result != test.expected {
+			t.Errorf("compare(%q, %q) = %d, expected %d", test.a, test.b, result, test.expected)
+		}
+		if result := b.compare(a); result != -test.expected {
+			t.Errorf("compare(%q, %q) = %d, expected %d", test.b, test.a, result, -test.expected)
+		}
+	}
+}
+
+func TestParseSemverInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "1", "1.2", "01.2.3", "1.2.3-", "1.2.3-01", "1.2.3.4", "edge.24-10-01"} {
+		if _, err := parseSemver(input); err == nil {
+			t.Errorf("parseSemver(%q) expected an error", input)
+		}
+	}
+}
+
+func TestSemverSatisfies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version    string
+		constraint string
+		expected   bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "= 1.2.3", true},
+		{"1.2.3", "!= 1.2.3", false},
+		{"1.2.3", ">= 1.2.0, < 2.0.0", true},
+		{"2.0.0", ">= 1.2.0, < 2.0.0", false},
+		{"1.2.3", "> 1.2", true},
+		{"1.2.0", "> 1.2", false},
+		{"1.9.0", "~> 1.2", true},
+		{"2.0.0", "~> 1.2", false},
+		{"1.2.9", "~> 1.2.3", true},
+		{"1.3.0", "~> 1.2.3", false},
+		{"1.3.0-rc.1", "~> 1.2.3", false},
+		{"1.9.0", "~> 1", true},
+		{"1.9.0", "^1.2.3", true},
+		{"2.0.0", "^1.2.3", false},
+		{"0.3.9", "^0.3.1", true},
+		{"0.4.0", "^0.3.1", false},
+		{"0.0.3", "^0.0.3", true},
+		{"0.0.4", "^0.0.3", false},
+		{"1.2.9", "~1.2.3", true},
+		{"1.3.0", "~1.2.3", false},
+		{"1.9.0", "~1", true},
+		{"1.0.0-rc.1", ">= 1.0.0-beta", true},
+		{"1.0.0-alpha", ">= 1.0.0-beta", false},
+	}
+
+	for _, test := range tests {
+		version, err := parseSemver(test.version)
+		if err != nil {
+			t.Fatalf("parseSemver(%q) returned error: %v", test.version, err)
+		}
+		constraints, err := parseSemverConstraints(test.constraint)
+		if err != nil {
+			t.Fatalf("parseSemverConstraints(%q) returned error: %v", test.constraint, err)
+		}
+		result := true
+		for _, constraint := range constraints {
+			result = result && constraint.satisfiedBy(version)
+		}
+		if result != test.expected {
+			t.Errorf("%q satisfies %q = %t, expected %t", test.version, test.constraint, result, test.expected)
+		}
+	}
+}
+
+func TestParseSemverConstraintsInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", ">=", "=> 1.2.3", ">= 1.2.3,", ">= one"} {
+		if _, err := parseSemverConstraints(input); err == nil {
+			t.Errorf("parseSemverConstraints(%q) expected an error", input)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"edge.24-10-01", "edge.24-10-01", 0},
+		{"edge.24-10-01", "edge.24-10-15", -1},
+		{"edge.24-09-30", "edge.24-10-01", -1},
+		{"edge.23-12-31", "edge.24-01-01", -1},
+		{"24-10.1", "stable.24-10.1", 0},
+		{"24-10.1", "24-10.2", -1},
+		{"24-10.2", "24-10.10", -1},
+		{"24-04.3", "24-10.1", -1},
+		{"edge.24-09-30", "24-10.1", -1},
+		{"24-10.5", "edge.24-10-01", -1},
+		{"edge", "edge.99-12-31", 1},
+		{"main", "local", 0},
+		{"1.2.3", "v1.10.0", -1},
+	}
+
+	for _, test := range tests {
+		result, err := compareVersions(test.a, test.b)
+		if err != nil {
+			t.Fatalf("compareVersions(%q, %q) returned error: %v", test.a, test.b, err)
+		}
+		if result != test.expected {
+			t.Errorf("compareVersions(%q, %q) = %d, expected %d", test.a, test.b, result, test.expected)
+		}
+		if result, _ := compareVersions(test.b, test.a); result != -test.expected {
+			t.Errorf("compareVersions(%q, %q) = %d, expected %d", test.b, test.a, result, -test.expected)
+		}
+	}
+}
+
+func TestCompareVersionsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+	}{
+		{"edge.24-13-01", "edge.24-10-01"},
+		{"24-10", "24-10.1"},
+		{"edge.24-10-01", "1.2.3"},
+		{"stable", "edge"},
+	}
+
+	for _, test := range tests {
+		if _, err := compareVersions(test.a, test.b); err == nil {
+			t.Errorf("compareVersions(%q, %q) expected an error", test.a, test.b)
+		}
+	}
+}
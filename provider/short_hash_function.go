@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var (
+	_ function.Function = ShortHashFunction{}
+)
+
+func NewShortHashFunction() function.Function {
+	return ShortHashFunction{}
+}
+
+type ShortHashFunction struct{}
+
+func (f ShortHashFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "short_hash"
+}
+
+func (f ShortHashFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a short, deterministic hash of a string that is safe to use in names",
+		Description: "Returns the first length characters of the SHA-256 hash of the input. Supported alphabets: hex (0-9a-f, up to 64 characters), base32 (lowercase a-z2-7, up to 52 characters), and base36 (0-9a-z, up to 50 characters). Every alphabet only contains characters that are valid in DNS labels, Kubernetes names, and AWS resource names.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The string to hash",
+				Name:               "input",
+			},
+			function.Int64Parameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The number of characters to return",
+				Name:               "length",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        fmt.Sprintf("The alphabet of the result: %s", strings.Join(hashAlphabetNames(), ", ")),
+				Name:               "alphabet",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ShortHashFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var length int64
+	var alphabet string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &length, &alphabet))
+	if resp.Error != nil {
+		return
+
+	}
+
+	hash, err := shortHash(input, int(length), alphabet)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newFuncError(err, -1))
+		return
+	}
+
+	tflog.Debug(ctx, "Computed short hash", map[string]any{"length": length, "alphabet": alphabet})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hash))
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+func TestSLAProfileDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                provider "pf" {
+                    sla_target = 2
+                }
+
+                data "pf_sla_profile" "test" {}
+
+                data "pf_sla_profile" "override" {
+                    sla_target_override = 3
+                }`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_sla_profile.test", tfjsonpath.New("target_availability"), knownvalue.StringExact("99.95%")),
+					statecheck.ExpectKnownValue("data.pf_sla_profile.test", tfjsonpath.New("min_replicas"), knownvalue.Int32Exact(2)),
+					statecheck.ExpectKnownValue("data.pf_sla_profile.override", tfjsonpath.New("sla_target"), knownvalue.Int32Exact(3)),
+					statecheck.ExpectKnownValue("data.pf_sla_profile.override", tfjsonpath.New("target_availability"), knownvalue.StringExact("99.99%")),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/**************************************************************
+  Provider Definition
+ **************************************************************/
+
+var _ datasource.DataSourceWithConfigure = &slaProfileDataSource{}
+
+func NewSLAProfileDataSource() datasource.DataSource {
+	return &slaProfileDataSource{}
+}
+
+type slaProfileDataSource struct {
+	panfactumDataSource
+}
+
+type slaProfileDataSourceModel struct {
+	SLATargetOverride               types.Int32  `tfsdk:"sla_target_override"`
+	SLATarget                       types.Int32  `tfsdk:"sla_target"`
+	TargetAvailability              types.String `tfsdk:"target_availability"`
+	MinReplicas                     types.Int32  `tfsdk:"min_replicas"`
+	PDBMinAvailable                 types.Int32  `tfsdk:"pdb_min_available"`
+	MultiAZ                         types.Bool   `tfsdk:"multi_az"`
+	TopologySpreadWhenUnsatisfiable types.String `tfsdk:"topology_spread_when_unsatisfiable"`
+	HostAntiAffinityRequired        types.Bool   `tfsdk:"host_anti_affinity_required"`
+	SpotInstancesAllowed            types.Bool   `tfsdk:"spot_instances_allowed"`
+	BurstableInstancesAllowed       types.Bool   `tfsdk:"burstable_instances_allowed"`
+	BackupRetentionDays             types.Int32  `tfsdk:"backup_retention_days"`
+	PointInTimeRecovery             types.Bool   `tfsdk:"point_in_time_recovery"`
+}
+
+func (d *slaProfileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_profile"
+}
+
+func (d *slaProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Provides the recommended deployment settings for the Panfactum SLA target." + unknownProviderConfigurationDescription,
+		MarkdownDescription: "Provides the recommended deployment settings for the Panfactum SLA target." + unknownProviderConfigurationDescription,
+
+		Attributes: map[string]schema.Attribute{
+			"sla_target_override": schema.Int32Attribute{
+				Description:         "Overrides the SLA target of the provider",
+				MarkdownDescription: "Overrides the SLA target of the provider",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AtMost(3),
+				},
+			},
+			"sla_target": schema.Int32Attribute{
+				Description:         "The SLA target that the settings were derived from",
+				MarkdownDescription: "The SLA target that the settings were derived from",
+				Computed:            true,
+			},
+			"target_availability": schema.StringAttribute{
+				Description:         "The monthly availability percentage that the SLA target aims to achieve",
+				MarkdownDescription: "The monthly availability percentage that the SLA target aims to achieve",
+				Computed:            true,
+			},
+			"min_replicas": schema.Int32Attribute{
+				Description:         "The minimum number of replicas that a highly available workload should run",
+				MarkdownDescription: "The minimum number of replicas that a highly available workload should run",
+				Computed:            true,
+			},
+			"pdb_min_available": schema.Int32Attribute{
+				Description:         "The minAvailable setting for the PodDisruptionBudget of a highly available workload",
+				MarkdownDescription: "The `minAvailable` setting for the PodDisruptionBudget of a highly available workload",
+				Computed:            true,
+			},
+			"multi_az": schema.BoolAttribute{
+				Description:         "Whether workloads and data stores must be spread across multiple availability zones",
+				MarkdownDescription: "Whether workloads and data stores must be spread across multiple availability zones",
+				Computed:            true,
+			},
+			"topology_spread_when_unsatisfiable": schema.StringAttribute{
+				Description:         "The whenUnsatisfiable setting for zone topology spread constraints",
+				MarkdownDescription: "The `whenUnsatisfiable` setting for zone topology spread constraints",
+				Computed:            true,
+			},
+			"host_anti_affinity_required": schema.BoolAttribute{
+				Description:         "Whether replicas of a workload must never be scheduled on the same node",
+				MarkdownDescription: "Whether replicas of a workload must never be scheduled on the same node",
+				Computed:            true,
+			},
+			"spot_instances_allowed": schema.BoolAttribute{
+				Description:         "Whether workloads may be scheduled on spot instances",
+				MarkdownDescription: "Whether workloads may be scheduled on spot instances",
+				Computed:            true,
+			},
+			"burstable_instances_allowed": schema.BoolAttribute{
+				Description:         "Whether workloads may be scheduled on burstable instances",
+				MarkdownDescription: "Whether workloads may be scheduled on burstable instances",
+				Computed:            true,
+			},
+			"backup_retention_days": schema.Int32Attribute{
+				Description:         "The number of days that backups of stateful systems should be retained",
+				MarkdownDescription: "The number of days that backups of stateful systems should be retained",
+				Computed:            true,
+			},
+			"point_in_time_recovery": schema.BoolAttribute{
+				Description:         "Whether stateful systems should enable point-in-time recovery",
+				MarkdownDescription: "Whether stateful systems should enable point-in-time recovery",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *slaProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	// Guard against reads before the provider has been configured
+	if !d.checkProviderConfigured(req, resp) {
+		return
+	}
+
+	var data slaProfileDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The profile derives from the provider's SLA target unless it is overridden
+	if data.SLATargetOverride.IsNull() && !d.checkProviderAttributesKnown(req, resp, "sla_target") {
+		return
+	}
+
+	// Allow the SLA target to be overridden
+	var slaTarget = d.ProviderData.SLATarget
+	if !data.SLATargetOverride.IsNull() && !data.SLATargetOverride.IsUnknown() {
+		slaTarget = data.SLATargetOverride
+	}
+
+	profile, err := getSLAProfile(slaTarget.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sla_target_override"), "Invalid SLA target", fmt.Sprintf("%v", err))
+		return
+	}
+
+	data.SLATarget = slaTarget
+	data.TargetAvailability = types.StringValue(profile.TargetAvailability)
+	data.MinReplicas = types.Int32Value(profile.MinReplicas)
+	data.PDBMinAvailable = types.Int32Value(profile.PDBMinAvailable)
+	data.MultiAZ = types.BoolValue(profile.MultiAZ)
+	data.TopologySpreadWhenUnsatisfiable = types.StringValue(profile.TopologySpreadWhenUnsatisfiable)
+	data.HostAntiAffinityRequired = types.BoolValue(profile.HostAntiAffinityRequired)
+	data.SpotInstancesAllowed = types.BoolValue(profile.SpotInstancesAllowed)
+	data.BurstableInstancesAllowed = types.BoolValue(profile.BurstableInstancesAllowed)
+	data.BackupRetentionDays = types.Int32Value(profile.BackupRetentionDays)
+	data.PointInTimeRecovery = types.BoolValue(profile.PointInTimeRecovery)
+
+	tflog.Debug(ctx, "Resolved SLA profile", map[string]any{
+		"sla_target":          slaTarget.ValueInt32(),
+		"target_availability": profile.TargetAvailability,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+/**************************************************************
+  Utility Functions
+ **************************************************************/
+
+type slaProfile struct {
+	TargetAvailability              string
+	MinReplicas                     int32
+	PDBMinAvailable                 int32
+	MultiAZ                         bool
+	TopologySpreadWhenUnsatisfiable string
+	HostAntiAffinityRequired        bool
+	SpotInstancesAllowed            bool
+	BurstableInstancesAllowed       bool
+	BackupRetentionDays             int32
+	PointInTimeRecovery             bool
+}
+
+// slaProfiles is the single source of truth for how each SLA target
+// translates into deployment settings:
+//
+//	1: 99.9%  - single-AZ failures are tolerated; cost is prioritized
+//	2: 99.95% - workloads survive the loss of a single AZ or node
+//	3: 99.99% - workloads survive the loss of a single AZ without relying on interruptible capacity
+var slaProfiles = map[int32]slaProfile{
+	1: {
+		TargetAvailability:              "99.9%",
+		MinReplicas:                     1,
+		PDBMinAvailable:                 0,
+		MultiAZ:                         false,
+		TopologySpreadWhenUnsatisfiable: "ScheduleAnyway",
+		HostAntiAffinityRequired:        false,
+		SpotInstancesAllowed:            true,
+		BurstableInstancesAllowed:       true,
+		BackupRetentionDays:             1,
+		PointInTimeRecovery:             false,
+	},
+	2: {
+		TargetAvailability:              "99.95%",
+		MinReplicas:                     2,
+		PDBMinAvailable:                 1,
+		MultiAZ:                         true,
+		TopologySpreadWhenUnsatisfiable: "DoNotSchedule",
+		HostAntiAffinityRequired:        false,
+		SpotInstancesAllowed:            true,
+		BurstableInstancesAllowed:       false,
+		BackupRetentionDays:             7,
+		PointInTimeRecovery:             true,
+	},
+	3: {
+		TargetAvailability:              "99.99%",
+		MinReplicas:                     3,
+		PDBMinAvailable:                 2,
+		MultiAZ:                         true,
+		TopologySpreadWhenUnsatisfiable: "DoNotSchedule",
+		HostAntiAffinityRequired:        true,
+		SpotInstancesAllowed:            false,
+		BurstableInstancesAllowed:       false,
+		BackupRetentionDays:             30,
+		PointInTimeRecovery:             true,
+	},
+}
+
+func getSLAProfile(slaTarget int32) (slaProfile, error) {
+	profile, ok := slaProfiles[slaTarget]
+	if !ok {
+		return slaProfile{}, fmt.Errorf("sla_target must be between 1 and 3, got %d", slaTarget)
+	}
+	return profile, nil
+}
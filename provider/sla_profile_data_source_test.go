@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestGetSLAProfile(t *testing.T) {
+	t.Parallel()
+
+	for slaTarget := int32(1); slaTarget <= 3; slaTarget++ {
+		if _, err := getSLAProfile(slaTarget); err != nil {
+			t.Errorf("expected a profile for sla_target %d: %v", slaTarget, err)
+		}
+	}
+
+	for _, slaTarget := range []int32{0, 4, -1} {
+		if _, err := getSLAProfile(slaTarget); err == nil {
+			t.Errorf("expected an error for sla_target %d", slaTarget)
+		}
+	}
+}
+
+// Higher SLA targets must never recommend less resilient settings than lower ones
+func TestGetSLAProfile_Monotonic(t *testing.T) {
+	t.Parallel()
+
+	for slaTarget := int32(2); slaTarget <= 3; slaTarget++ {
+		lower, _ := getSLAProfile(slaTarget - 1)
+		higher, _ := getSLAProfile(slaTarget)
+
+		if higher.MinReplicas < lower.MinReplicas {
+			t.Errorf("sla_target %d has fewer min_replicas than sla_target %d", slaTarget, slaTarget-1)
+		}
+		if higher.PDBMinAvailable < lower.PDBMinAvailable {
+			t.Errorf("sla_target %d has a lower pdb_min_available than sla_target %d", slaTarget, slaTarget-1)
+		}
+		if higher.BackupRetentionDays < lower.BackupRetentionDays {
+			t.Errorf("sla_target %d has a shorter backup_retention_days than sla_target %d", slaTarget, slaTarget-1)
+		}
+		if lower.MultiAZ && !higher.MultiAZ {
+			t.Errorf("sla_target %d disables multi_az", slaTarget)
+		}
+		if !lower.SpotInstancesAllowed && higher.SpotInstancesAllowed {
+			t.Errorf("sla_target %d allows spot instances", slaTarget)
+		}
+		if lower.PointInTimeRecovery && !higher.PointInTimeRecovery {
+			t.Errorf("sla_target %d disables point_in_time_recovery", slaTarget)
+		}
+	}
+}
+
+// The PDB must always allow at least one pod to be disrupted or nodes can never be drained
+func TestGetSLAProfile_PDBAllowsDisruption(t *testing.T) {
+	t.Parallel()
+
+	for slaTarget := int32(1); slaTarget <= 3; slaTarget++ {
+		profile, _ := getSLAProfile(slaTarget)
+		if profile.PDBMinAvailable >= profile.MinReplicas {
+			t.Errorf("sla_target %d has pdb_min_available >= min_replicas", slaTarget)
+		}
+	}
+}
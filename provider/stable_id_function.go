@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = StableIDFunction{}
+)
+
+func NewStableIDFunction() function.Function {
+	return StableIDFunction{}
+}
+
+type StableIDFunction struct{}
+
+func (f StableIDFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "stable_id"
+}
+
+func (f StableIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a 16 character identifier that is derived from the given strings",
+		Description: "The same strings always produce the same identifier. The result is a base36 (0-9a-z) hash of the strings, which are combined in a way that avoids ambiguity (e.g., stable_id(\"a-b\") and stable_id(\"a\", \"b\") differ).",
+		VariadicParameter: function.StringParameter{
+			AllowNullValue:     false,
+			AllowUnknownValues: false,
+			Description:        "The strings to derive the identifier from",
+			Name:               "parts",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f StableIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+
+	}
+
+	id := stableID(parts)
+	tflog.Debug(ctx, "Computed stable id", map[string]any{"parts": len(parts), "id": id})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
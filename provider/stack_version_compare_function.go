@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = StackVersionCompareFunction{}
+)
+
+func NewStackVersionCompareFunction() function.Function {
+	return StackVersionCompareFunction{}
+}
+
+type StackVersionCompareFunction struct{}
+
+func (f StackVersionCompareFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "stack_version_compare"
+}
+
+func (f StackVersionCompareFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compares two Panfactum Stack versions",
+		Description: "Returns -1 if a is older than b, 0 if they are the same release, and 1 if a is newer than b. Understands edge releases (edge.YY-MM-DD) and stable releases (stable.YY-MM.N or YY-MM.N). Stable releases are branched at the start of their month, so they sort after the previous month's edge releases and before their own month's edge releases. The unreleased heads 'edge', 'main', and 'local' are newer than every release. If both versions are semantic versions, they are compared as such.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The first version (e.g., edge.24-10-01)",
+				Name:               "a",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The second version (e.g., 24-10.1)",
+				Name:               "b",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f StackVersionCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+
+	}
+
+	result, err := compareVersions(a, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newFuncError(err, -1))
+		return
+	}
+
+	tflog.Debug(ctx, "Compared Panfactum Stack versions", map[string]any{"a": a, "b": b, "result": result})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, int64(result)))
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"reflect"
+	"testing"
+)
+
+// readTestMap reads the data source and returns the map attribute at the given path
+func readTestMap(t *testing.T, ds datasource.DataSource, providerData *PanfactumProvider, values map[string]tftypes.Value, attribute string) map[string]string {
+	t.Helper()
+
+	resp := readTestDataSourceResponse(t, ds, providerData, datasource.ReadClientCapabilities{}, values)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var result map[string]string
+	if diags := resp.State.GetAttribute(context.Background(), path.Root(attribute), &result); diags.HasError() {
+		t.Fatalf("unable to read %s: %v", attribute, diags)
+	}
+	return result
+}
+
+func newTestTagsProviderData() *PanfactumProvider {
+	providerData := newTestProviderData()
+	providerData.RootModule = types.StringValue("aws_eks")
+	providerData.StackVersion = types.StringValue("edge.24-10-01")
+	providerData.StackCommit = types.StringValue("0123456789abcdef")
+	providerData.IsLocal = types.BoolNull()
+	providerData.ExtraTags = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Cost Center":          types.StringValue("platform team"),
+		"example.com/owner":    types.StringValue("owner@example.com"),
+		"panfactum.com/module": types.StringValue("overridden"),
+	})
+	return providerData
+}
+
+func TestAWSTagsContract(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		config   map[string]tftypes.Value
+		expected map[string]string
+	}{
+		{
+			"defaults",
+			map[string]tftypes.Value{"module": tftypes.NewValue(tftypes.String, "vault")},
+			map[string]string{
+				// is_local is unset, which is rendered as <null>
+				"panfactum.com/local":         "<null>",
+				"panfactum.com/environment":   "production",
+				"panfactum.com/region":        "us-east-2",
+				"panfactum.com/stack-version": "edge.24-10-01",
+				"panfactum.com/stack-commit":  "0123456789abcdef",
+				"panfactum.com/root-module":   "aws_eks",
+				// Extra tags take precedence over the standard tags
+				"panfactum.com/module": "overridden",
+				// Extra tags are sanitized
+				"Cost.Center":       "platform.team",
+				"example.com/owner": "owner@example.com",
+			},
+		},
+		{
+			"region_override without module",
+			map[string]tftypes.Value{"region_override": tftypes.NewValue(tftypes.String, "eu-central-1")},
+			map[string]string{
+				// is_local is unset, which is rendered as <null>
+				"panfactum.com/local":         "<null>",
+				"panfactum.com/environment":   "production",
+				"panfactum.com/region":        "eu-central-1",
+				"panfactum.com/stack-version": "edge.24-10-01",
+				"panfactum.com/stack-commit":  "0123456789abcdef",
+				"panfactum.com/root-module":   "aws_eks",
+				"panfactum.com/module":        "overridden",
+				"Cost.Center":                 "platform.team",
+				"example.com/owner":           "owner@example.com",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		if actual := readTestMap(t, NewAWSTagsDataSource(), newTestTagsProviderData(), test.config, "tags"); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected tags %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestKubeLabelsContract(t *testing.T) {
+	t.Parallel()
+
+	providerData := newTestTagsProviderData()
+	providerData.IsLocal = types.BoolValue(true)
+	providerData.StackVersion = types.StringValue("edge.24-10-01+local")
+
+	expected := map[string]string{
+		"panfactum.com/local":       "true",
+		"panfactum.com/environment": "production",
+		"panfactum.com/region":      "us-east-2",
+		// Labels are sanitized
+		"panfactum.com/stack-version": "edge.24-10-01.local",
+		"panfactum.com/stack-commit":  "0123456789abcdef",
+		"panfactum.com/root-module":   "aws_eks",
+		"panfactum.com/module":        "overridden",
+		"Cost.Center":                 "platform.team",
+		"example.com/owner":           "owner.example.com",
+	}
+
+	config := map[string]tftypes.Value{"module": tftypes.NewValue(tftypes.String, "vault")}
+	if actual := readTestMap(t, NewKubeLabelsDataSource(), providerData, config, "labels"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected labels %v, got %v", expected, actual)
+	}
+}
+
+func TestKubeSchedulingMatchLabelsContract(t *testing.T) {
+	t.Parallel()
+
+	// The default selector must not change when the stack is upgraded
+	expected := map[string]string{
+		"panfactum.com/local":       "<null>",
+		"panfactum.com/environment": "production",
+		"panfactum.com/region":      "us-east-2",
+		"panfactum.com/root-module": "aws_eks",
+		"panfactum.com/module":      "overridden",
+		"Cost.Center":               "platform.team",
+		"example.com/owner":         "owner.example.com",
+	}
+
+	config := map[string]tftypes.Value{"module": tftypes.NewValue(tftypes.String, "vault")}
+	if actual := readTestMap(t, NewKubeSchedulingDataSource(), newTestTagsProviderData(), config, "match_labels"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected match labels %v, got %v", expected, actual)
+	}
+
+	// The stack version and commit are not needed to select the pods, so they may be unknown
+	providerData := newTestTagsProviderData()
+	providerData.UnknownAttributes = []string{"stack_version", "stack_commit"}
+	if actual := readTestMap(t, NewKubeSchedulingDataSource(), providerData, config, "match_labels"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected match labels with an unknown stack version %v, got %v", expected, actual)
+	}
+}
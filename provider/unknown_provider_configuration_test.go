@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"regexp"
+	"terraform-provider-pf/provider"
+	"testing"
+)
+
+// The provider configuration references a resource that has not been created yet, so the
+// data sources that depend on it are read during apply once the configuration is known
+func TestDataSources_UnknownProviderConfiguration(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// terraform_data
+			tfversion.SkipBelow(tfversion.Version1_4_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+                resource "terraform_data" "environment" {
+                    input = "production"
+                }
+
+                provider "pf" {
+                    environment = terraform_data.environment.output
+                    region      = "us-east-2"
+                }
+
+                data "pf_metadata" "test" {
+                    depends_on = [terraform_data.environment]
+                }
+
+                data "pf_aws_tags" "test" {
+                    module     = "vault"
+                    depends_on = [terraform_data.environment]
+                }
+
+                data "pf_kube_labels" "test" {
+                    module     = "vault"
+                    depends_on = [terraform_data.environment]
+                }`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.pf_metadata.test", plancheck.ResourceActionRead),
+						plancheck.ExpectUnknownValue("data.pf_metadata.test", tfjsonpath.New("environment")),
+						plancheck.ExpectUnknownValue("data.pf_aws_tags.test", tfjsonpath.New("tags")),
+						plancheck.ExpectUnknownValue("data.pf_kube_labels.test", tfjsonpath.New("labels")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("environment"), knownvalue.StringExact("production")),
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("is_production"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.pf_aws_tags.test", tfjsonpath.New("tags").AtMapKey("panfactum.com/environment"), knownvalue.StringExact("production")),
+					statecheck.ExpectKnownValue("data.pf_kube_labels.test", tfjsonpath.New("labels").AtMapKey("panfactum.com/environment"), knownvalue.StringExact("production")),
+				},
+			},
+		},
+	})
+}
+
+// Data sources that derive their outputs from unknown provider attributes cannot be read during
+// the plan, and are resolved during apply once they depend on the resources that the attributes reference
+func TestDataSources_UnknownProviderConfiguration_PlanApplyCycle(t *testing.T) {
+	t.Parallel()
+
+	config := func(team string, dependsOn string) string {
+		return fmt.Sprintf(`
+        resource "terraform_data" "tags" {
+            input = { team = "%s" }
+        }
+
+        provider "pf" {
+            environment = "production"
+            extra_tags  = terraform_data.tags.output
+        }
+
+        data "pf_aws_tags" "test" {
+            module     = "vault"
+            depends_on = [%s]
+        }
+
+        data "pf_kube_labels" "test" {
+            module     = "vault"
+            depends_on = [%s]
+        }
+
+        data "pf_metadata" "test" {}`, team, dependsOn, dependsOn)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pf": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// terraform_data
+			tfversion.SkipBelow(tfversion.Version1_4_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config("platform", ""),
+				ExpectError: regexp.MustCompile(`provider attributes extra_tags,\s+which are not known until apply`),
+			},
+			{
+				Config: config("platform", "terraform_data.tags"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("data.pf_aws_tags.test", tfjsonpath.New("tags")),
+						plancheck.ExpectUnknownValue("data.pf_kube_labels.test", tfjsonpath.New("labels")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_aws_tags.test", tfjsonpath.New("tags").AtMapKey("team"), knownvalue.StringExact("platform")),
+					statecheck.ExpectKnownValue("data.pf_kube_labels.test", tfjsonpath.New("labels").AtMapKey("team"), knownvalue.StringExact("platform")),
+					// The metadata does not derive from extra_tags, so it is read during the plan
+					statecheck.ExpectKnownValue("data.pf_metadata.test", tfjsonpath.New("environment"), knownvalue.StringExact("production")),
+				},
+			},
+			{
+				Config: config("infrastructure", "terraform_data.tags"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.pf_aws_tags.test", tfjsonpath.New("tags").AtMapKey("team"), knownvalue.StringExact("infrastructure")),
+					statecheck.ExpectKnownValue("data.pf_kube_labels.test", tfjsonpath.New("labels").AtMapKey("team"), knownvalue.StringExact("infrastructure")),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = ValidateKubeNameFunction{}
+)
+
+func NewValidateKubeNameFunction() function.Function {
+	return ValidateKubeNameFunction{}
+}
+
+type ValidateKubeNameFunction struct{}
+
+func (f ValidateKubeNameFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_kube_name"
+}
+
+func (f ValidateKubeNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns true if the name is a valid Kubernetes object name for the object kind",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The name to validate",
+				Name:               "name",
+			},
+			function.StringParameter{
+				AllowNullValue:     false,
+				AllowUnknownValues: false,
+				Description:        "The kind of the Kubernetes object (e.g., Deployment) or the name of the naming rule (e.g., dns_1123_label)",
+				Name:               "kind",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f ValidateKubeNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, kind string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &kind))
+	if resp.Error != nil {
+		return
+
+	}
+
+	rule, err := getKubeNameRule(kind)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	valid := rule.validate(name)
+	tflog.Debug(ctx, "Validated Kubernetes name", map[string]any{"name": name, "kind": kind, "valid": valid})
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, valid))
+}